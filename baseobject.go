@@ -0,0 +1,2076 @@
+package rxlib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/helpers"
+	"github.com/NubeIO/rxlib/libs/bus"
+	"github.com/NubeIO/rxlib/payload"
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"github.com/NubeIO/schema"
+	"github.com/patrickmn/go-cache"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	eventBus     *bus.Bus
+	eventBusOnce sync.Once
+)
+
+// EventBus is the eventbus the BaseObject ports publish on when BaseObjectOpts.Bus is not set, the last value of each topic is retained
+// so a new connection gets the current output value
+func EventBus() *bus.Bus {
+	eventBusOnce.Do(func() {
+		eventBus, _ = bus.NewBus(bus.Next(func() string { return helpers.UUID() }))
+		eventBus.SetAutoRoute(true)
+		eventBus.SetRetainOptions(bus.RetainOptions{Enabled: true})
+	})
+	return eventBus
+}
+
+// PortTopic is the eventbus topic the value of an output is published on; eg PortTopic(uuid, "output")
+func PortTopic(objectUUID, portID string) string {
+	return fmt.Sprintf("ports/%s/%s", objectUUID, portID)
+}
+
+// CommandTopic is the eventbus topic PublishCommand() sends the commands of an object on
+func CommandTopic(objectUUID string) string {
+	return fmt.Sprintf("commands/%s", objectUUID)
+}
+
+type BaseObjectOpts struct {
+	Bus *bus.Bus // the eventbus for the port values, it must have auto route enabled; default EventBus()
+}
+
+type subscription struct {
+	sourceUUID string
+	sourcePort string
+}
+
+// BaseObject implements the Object interface so it can be embedded, a concrete object only needs its own Init(), Start() and Process().
+// Pass the concrete object to New() so the BaseObject calls its methods, eg for the input updates:
+//
+//	type Add struct{ *rxlib.BaseObject }
+//	add := &Add{BaseObject: rxlib.NewBaseObject(info, nil)}
+//	return add.New(add)
+//
+// The outputs are published on the eventbus on PortTopic(), and a subscriber connection subscribes the input to it.
+// For the proto level objects of a plugin see reactive.BaseObject
+type BaseObject struct {
+	self    Object
+	mutex   sync.RWMutex
+	runtime Runtime
+	bus     *bus.Bus
+
+	info     *runtime.Info
+	meta     *runtime.Meta
+	settings *runtime.ObjectSettings
+	stats    *runtime.ObjectStats
+	loaded   bool
+	locked   bool
+
+	inputs        []*Port
+	outputs       []*Port
+	dynamicInputs map[string]int // the port ids added by BuildDynamicInputs, by index
+	connections   []*runtime.Connection
+	subscriptions map[string]subscription // the eventbus handlers of the subscriber connections, by handler key
+	handlers      map[string]bool         // the eventbus handlers added with Subscribe() and SubscribePayload()
+	publishers    map[string]*publisher   // the publish state of the outputs with PublishOpts, by port id
+
+	extensions         []Object
+	requiredExtensions []*Extension
+	validations        map[string]*ErrorsAndValidation
+	cache              *cache.Cache
+	logger             *Logger
+}
+
+func NewBaseObject(info *runtime.Info, opts *BaseObjectOpts) *BaseObject {
+	if opts == nil {
+		opts = &BaseObjectOpts{}
+	}
+	if opts.Bus == nil {
+		opts.Bus = EventBus()
+	}
+	inst := &BaseObject{
+		bus:           opts.Bus,
+		meta:          &runtime.Meta{ObjectUUID: helpers.UUID(), Position: &runtime.Position{}},
+		settings:      &runtime.ObjectSettings{},
+		stats:         &runtime.ObjectStats{},
+		dynamicInputs: make(map[string]int),
+		subscriptions: make(map[string]subscription),
+		handlers:      make(map[string]bool),
+		publishers:    make(map[string]*publisher),
+		validations:   make(map[string]*ErrorsAndValidation),
+		cache:         cache.New(cache.NoExpiration, 10*time.Minute),
+	}
+	if info == nil {
+		info = &runtime.Info{}
+	}
+	inst.SetInfo(info)
+	inst.meta.ObjectName = info.GetObjectID()
+	return inst
+}
+
+// New sets the concrete object that embeds the BaseObject and returns it
+func (inst *BaseObject) New(object Object, opts ...any) Object {
+	if object == nil {
+		return inst
+	}
+	inst.self = object
+	return object
+}
+
+// object is the concrete object, so its overridden methods are called
+func (inst *BaseObject) object() Object {
+	if inst.self != nil {
+		return inst.self
+	}
+	return inst
+}
+
+func (inst *BaseObject) Init() error {
+	return nil
+}
+
+func (inst *BaseObject) Start() error {
+	inst.SetLoaded()
+	return nil
+}
+
+func (inst *BaseObject) Process() error {
+	return nil
+}
+
+func (inst *BaseObject) SetLoaded() {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.loaded = true
+	inst.stats.Loaded = time.Now().Format(time.RFC3339)
+}
+
+func (inst *BaseObject) IsNotLoaded() bool {
+	return !inst.IsLoaded()
+}
+
+func (inst *BaseObject) IsLoaded() bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.loaded
+}
+
+func (inst *BaseObject) CommandList() []*Invoke {
+	return nil
+}
+
+// Reset resets the loop count, it is called on a deploy when the requirement CallResetOnDeploy is set
+func (inst *BaseObject) Reset() error {
+	inst.ResetLoopCount()
+	return nil
+}
+
+func (inst *BaseObject) AllowsReset() bool {
+	return inst.GetRequirements().GetCallResetOnDeploy()
+}
+
+// Delete unsubscribes the inputs and drops the connections, it is called when the object is removed from the runtime
+func (inst *BaseObject) Delete() error {
+	inst.mutex.Lock()
+	var keys []string
+	for key := range inst.subscriptions {
+		keys = append(keys, key)
+	}
+	for key := range inst.handlers {
+		keys = append(keys, key)
+	}
+	inst.subscriptions = make(map[string]subscription)
+	inst.handlers = make(map[string]bool)
+	var topics []string
+	for _, port := range inst.outputs {
+		topics = append(topics, PortTopic(inst.meta.GetObjectUUID(), port.ID))
+	}
+	inst.mutex.Unlock()
+	for _, key := range keys {
+		inst.bus.DeregisterHandler(key)
+	}
+	inst.stopPublishers()
+	inst.bus.ClearRetained(topics...)
+	return errors.Join(inst.DropConnections()...)
+}
+
+// Lock marks the object as locked, eg so a user can't edit it while a driver is writing to it
+func (inst *BaseObject) Lock() {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.locked = true
+}
+
+func (inst *BaseObject) Unlock() {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.locked = false
+}
+
+func (inst *BaseObject) IsLocked() bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.locked
+}
+
+func (inst *BaseObject) IsUnlocked() bool {
+	return !inst.IsLocked()
+}
+
+// InvokePayload updates the input of the payload PortID
+func (inst *BaseObject) InvokePayload(p *payload.Payload) error {
+	if p == nil || p.PortValue == nil {
+		return errors.New("payload can not be empty")
+	}
+	if err := inst.updateInput(p.GetPortID(), p); err != nil {
+		return err
+	}
+	inst.triggerFlow()
+	return nil
+}
+
+func (inst *BaseObject) Invoke(command *ExtendedCommand) (*CommandResponse, error) {
+	return nil, fmt.Errorf("object: %s does not support invoke", inst.GetID())
+}
+
+// CommandObject runs the command on the runtime, eg to get another object
+func (inst *BaseObject) CommandObject(command *ExtendedCommand) *CommandResponse {
+	if inst.Runtime() == nil {
+		return &CommandResponse{Error: fmt.Sprintf("object: %s has not been added to a runtime", inst.GetUUID())}
+	}
+	return inst.Runtime().CommandObject(command)
+}
+
+func (inst *BaseObject) Command(command *ExtendedCommand) *runtime.CommandResponse {
+	if inst.Runtime() == nil {
+		return &runtime.CommandResponse{TypeError: fmt.Sprintf("object: %s has not been added to a runtime", inst.GetUUID())}
+	}
+	return inst.Runtime().Command(command)
+}
+
+func (inst *BaseObject) CommandResponse(response *runtime.CommandResponse) {}
+
+func (inst *BaseObject) AddRuntime(r Runtime) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.runtime = r
+}
+
+func (inst *BaseObject) Runtime() Runtime {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.runtime
+}
+
+func (inst *BaseObject) RemoveObjectFromRuntime() {
+	if inst.Runtime() == nil {
+		return
+	}
+	if err := inst.Runtime().DeleteByUUID(inst.GetUUID()); err != nil {
+		log.Printf("remove object: %s err: %v", inst.GetUUID(), err)
+	}
+}
+
+func (inst *BaseObject) GetParentObject() Object {
+	if inst.Runtime() == nil || inst.GetParentUUID() == "" {
+		return nil
+	}
+	return inst.Runtime().GetByUUID(inst.GetParentUUID())
+}
+
+func (inst *BaseObject) GetParentUUID() string {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.meta.GetParentUUID()
+}
+
+// -------------------EXTENSIONS------------------
+
+func (inst *BaseObject) AddExtension(extension Object) error {
+	if extension == nil {
+		return errors.New("extension can not be empty")
+	}
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	for _, e := range inst.extensions {
+		if e.GetID() == extension.GetID() {
+			return fmt.Errorf("object: %s already has the extension: %s", inst.meta.GetObjectUUID(), extension.GetID())
+		}
+	}
+	inst.extensions = append(inst.extensions, extension)
+	return nil
+}
+
+func (inst *BaseObject) GetExtensions() []Object {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return slices.Clone(inst.extensions)
+}
+
+func (inst *BaseObject) GetExtension(id string) (Object, error) {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	for _, e := range inst.extensions {
+		if e.GetID() == id {
+			return e, nil
+		}
+	}
+	return nil, fmt.Errorf("extension: %s not found", id)
+}
+
+func (inst *BaseObject) DeleteExtension(name string) error {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	for i, e := range inst.extensions {
+		if e.GetID() == name {
+			inst.extensions = slices.Delete(inst.extensions, i, i+1)
+			return nil
+		}
+	}
+	return fmt.Errorf("extension: %s not found", name)
+}
+
+func (inst *BaseObject) SetRequiredExtensions(extension []*Extension) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.requiredExtensions = extension
+}
+
+func (inst *BaseObject) GetRequiredExtensions() []*Extension {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.requiredExtensions
+}
+
+func (inst *BaseObject) RequiredExtensionListCount() (extensionsCount int) {
+	return len(inst.GetRequiredExtensions())
+}
+
+func (inst *BaseObject) IsExtensionsAdded(objectID string) (addedCount int) {
+	for _, e := range inst.GetExtensions() {
+		if e.GetID() == objectID {
+			addedCount++
+		}
+	}
+	return addedCount
+}
+
+func (inst *BaseObject) GetRequiredExtensionByName(extensionName string) *Extension {
+	for _, e := range inst.GetRequiredExtensions() {
+		if e.ExtensionName == extensionName {
+			return e
+		}
+	}
+	return nil
+}
+
+// -------------------PORTS------------------
+
+func newPortPayload(portID string, dataType priority.Type) *payload.Payload {
+	pv := &runtime.PortValue{PortID: portID, DataType: string(dataType), IsNil: true, Quality: string(payload.QualityUncertain)}
+	return &payload.Payload{PortValue: pv}
+}
+
+// NewPort adds the port as an input or output by its Direction
+func (inst *BaseObject) NewPort(port *Port) {
+	if port.UUID == "" {
+		port.UUID = helpers.UUID()
+	}
+	if port.Payload == nil {
+		port.Payload = newPortPayload(port.ID, port.DataType)
+	}
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	if port.Direction == Output {
+		inst.outputs = append(inst.outputs, port)
+	} else {
+		port.Direction = Input
+		inst.inputs = append(inst.inputs, port)
+	}
+}
+
+func (inst *BaseObject) newPort(port *NewPort, direction PortDirection) error {
+	if port == nil || port.ID == "" {
+		return errors.New("port id can not be empty")
+	}
+	if inst.getPort(port.ID) != nil {
+		return fmt.Errorf("object: %s port: %s already exists", inst.GetUUID(), port.ID)
+	}
+	name := port.Name
+	if name == "" {
+		name = port.ID
+	}
+	inst.NewPort(&Port{
+		ID:                       port.ID,
+		Name:                     name,
+		Direction:                direction,
+		DataType:                 port.DataType,
+		AllowMultipleConnections: port.AllowMultipleConnections,
+		OnlyPublishOnCOV:         port.OnlyPublishOnCOV,
+		Publish:                  port.Publish,
+		DefaultPosition:          port.DefaultPosition,
+		HiddenByDefault:          port.HiddenByDefault,
+		EnablePersistence:        port.EnablePersistence,
+		MaxPersistenceCount:      port.MaxPersistenceCount,
+		MaxAge:                   port.MaxAge,
+		OnMessage:                port.OnMessage,
+	})
+	return nil
+}
+
+func (inst *BaseObject) NewInputPort(port *NewPort) error {
+	return inst.newPort(port, Input)
+}
+
+func (inst *BaseObject) NewInputPorts(ports []*NewPort) error {
+	var errs []error
+	for _, port := range ports {
+		errs = append(errs, inst.NewInputPort(port))
+	}
+	return errors.Join(errs...)
+}
+
+func (inst *BaseObject) NewOutputPort(port *NewPort) error {
+	return inst.newPort(port, Output)
+}
+
+func (inst *BaseObject) NewOutputPorts(ports []*NewPort) error {
+	var errs []error
+	for _, port := range ports {
+		errs = append(errs, inst.NewOutputPort(port))
+	}
+	return errors.Join(errs...)
+}
+
+func (inst *BaseObject) getPort(portID string) *Port {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.findPort(portID)
+}
+
+// findPort must be called with the mutex held
+func (inst *BaseObject) findPort(portID string) *Port {
+	for _, port := range inst.inputs {
+		if port.ID == portID {
+			return port
+		}
+	}
+	for _, port := range inst.outputs {
+		if port.ID == portID {
+			return port
+		}
+	}
+	return nil
+}
+
+func (inst *BaseObject) GetAllPorts() []*Port {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return append(slices.Clone(inst.inputs), inst.outputs...)
+}
+
+func (inst *BaseObject) RestorePersistedValues(value *ObjectPersistenceValue) error {
+	if value == nil {
+		return errors.New("persisted value can not be empty")
+	}
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	port := inst.findPort(value.PortID)
+	if port == nil {
+		return fmt.Errorf("object: %s port: %s not found", inst.meta.GetObjectUUID(), value.PortID)
+	}
+	var v any
+	switch {
+	case value.ValueFloat != nil:
+		v = *value.ValueFloat
+	case value.ValueInt != nil:
+		v = *value.ValueInt
+	case value.ValueBool != nil:
+		v = *value.ValueBool
+	case value.ValueString != nil:
+		v = *value.ValueString
+	}
+	pv, err := newPortValue(port, v)
+	if err != nil {
+		return err
+	}
+	// it is not known when the value was read, so it is uncertain until it is updated
+	port.Payload = &payload.Payload{PortValue: pv, Timestamp: time.Now()}
+	port.Payload.SetValueQuality(payload.QualityUncertain)
+	return nil
+}
+
+func (inst *BaseObject) PortsWithPersistenceEnabled() []*Port {
+	var out []*Port
+	for _, port := range inst.GetAllPorts() {
+		if port.HasPersistence() {
+			out = append(out, port)
+		}
+	}
+	return out
+}
+
+func (inst *BaseObject) GetPortPayload(portID string) (*payload.Payload, error) {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	port := inst.findPort(portID)
+	if port == nil {
+		return nil, fmt.Errorf("object: %s port: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	return port.GetPayload(), nil
+}
+
+// GetPortValue returns a copy of the port value
+func (inst *BaseObject) GetPortValue(portID string) *runtime.PortValue {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	port := inst.findPort(portID)
+	if port == nil || port.GetPayload() == nil || port.GetPayload().PortValue == nil {
+		return nil
+	}
+	value := proto.Clone(port.GetPayload().PortValue).(*runtime.PortValue)
+	value.ObjectUUID = inst.meta.GetObjectUUID()
+	value.PortID = portID
+	return value
+}
+
+func (inst *BaseObject) EnablePort(portID string) error {
+	return inst.setPortDisabled(portID, false)
+}
+
+func (inst *BaseObject) DisablePort(portID string) error {
+	return inst.setPortDisabled(portID, true)
+}
+
+func (inst *BaseObject) setPortDisabled(portID string, disabled bool) error {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	port := inst.findPort(portID)
+	if port == nil {
+		return fmt.Errorf("object: %s port: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	port.Disabled = disabled
+	return nil
+}
+
+func (inst *BaseObject) IsPortDisable(portID string) (bool, error) {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	port := inst.findPort(portID)
+	if port == nil {
+		return false, fmt.Errorf("object: %s port: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	return port.IsDisabled(), nil
+}
+
+// AddTransformation sets the transformation of the port, it is applied to the float values written to the port
+func (inst *BaseObject) AddTransformation(portID string, transformation *priority.Transformations, applyTransformation bool) error {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	port := inst.findPort(portID)
+	if port == nil {
+		return fmt.Errorf("object: %s port: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	port.Transformation = transformation
+	port.UsingTransformation = transformation != nil
+	if applyTransformation {
+		return applyPortTransformation(port)
+	}
+	return nil
+}
+
+func (inst *BaseObject) AddAllTransformations(inputs, outputs []*Port) []error {
+	var errs []error
+	for _, port := range append(slices.Clone(inputs), outputs...) {
+		if port.GetTransformation() == nil {
+			continue
+		}
+		if err := inst.AddTransformation(port.ID, port.GetTransformation(), true); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// applyPortTransformation transforms the float value of the port, the value before it is kept in the payload
+func applyPortTransformation(port *Port) error {
+	t := port.GetTransformation()
+	p := port.GetPayload()
+	if t == nil || !t.EnableTransformation || p == nil || p.PortValue == nil || p.FloatValue == nil {
+		return nil
+	}
+	value, err := priority.TransformationsBuilder(p.FloatValue, t)
+	if err != nil {
+		return fmt.Errorf("port: %s transformation err: %v", port.ID, err)
+	}
+	if outOfRange(*p.FloatValue, t) {
+		p.SetValueQuality(payload.WorstQuality(p.ValueQuality(), payload.QualityOutOfRange))
+	}
+	p.SetTransformationExistingValueFloat(p.FloatValue)
+	p.FloatValue = value
+	p.IsNil = value == nil
+	p.PortValue.TransformationApplied = true
+	port.TransformationApplied = true
+	return nil
+}
+
+// OverrideValue overrides the port until ReleaseOverride(), an overridden output publishes the override value
+func (inst *BaseObject) OverrideValue(value any, portID string) error {
+	inst.mutex.Lock()
+	port := inst.findPort(portID)
+	if port == nil {
+		inst.mutex.Unlock()
+		return fmt.Errorf("object: %s port: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	err := port.SetOverride(value)
+	output := port.Direction == Output
+	persist := port.HasPersistence()
+	inst.mutex.Unlock()
+	if err != nil {
+		return fmt.Errorf("object: %s port: %s override err: %v", inst.GetUUID(), portID, err)
+	}
+	if persist {
+		inst.persistPort(portID)
+	}
+	if output {
+		return inst.PublishValue(portID)
+	}
+	return nil
+}
+
+// ReleaseOverride restores the value the port had before the override, or the last value it got while it was overridden
+func (inst *BaseObject) ReleaseOverride(portID string) error {
+	inst.mutex.Lock()
+	port := inst.findPort(portID)
+	if port == nil {
+		inst.mutex.Unlock()
+		return fmt.Errorf("object: %s port: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	if !port.OverrideApplied {
+		inst.mutex.Unlock()
+		return fmt.Errorf("object: %s port: %s is not overridden", inst.meta.GetObjectUUID(), portID)
+	}
+	port.Release()
+	output := port.Direction == Output
+	persist := port.HasPersistence()
+	inst.mutex.Unlock()
+	if persist {
+		inst.persistPort(portID)
+	}
+	if output {
+		return inst.PublishValue(portID)
+	}
+	return nil
+}
+
+// outOfRange is if the value is limited by the min/max of the transformation
+func outOfRange(value float64, t *priority.Transformations) bool {
+	if !t.ApplyMinMax || t.ApplyScale || t.MinMaxValue == nil {
+		return false
+	}
+	limits := t.MinMaxValue
+	return (limits.MinValue != nil && value < *limits.MinValue) || (limits.MaxValue != nil && value > *limits.MaxValue)
+}
+
+// -------------------CONNECTIONS------------------
+
+// connectionPort is the port of this object the connection is on
+func (inst *BaseObject) connectionPort(connection *runtime.Connection) string {
+	if connection.GetFlowDirection() == DirectionSubscriber || connection.GetSourceUUID() != inst.meta.GetObjectUUID() {
+		return connection.GetTargetPort()
+	}
+	return connection.GetSourcePort()
+}
+
+// setHasConnection must be called with the mutex held
+func (inst *BaseObject) setHasConnection(portID string) {
+	port := inst.findPort(portID)
+	if port == nil {
+		return
+	}
+	port.HasConnection = false
+	for _, c := range inst.connections {
+		if inst.connectionPort(c) == portID {
+			port.HasConnection = true
+			return
+		}
+	}
+}
+
+// CreateConnection adds or replaces the connection, without subscribing to it
+func (inst *BaseObject) CreateConnection(connection *runtime.Connection) {
+	if connection == nil {
+		return
+	}
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	i := slices.IndexFunc(inst.connections, func(c *runtime.Connection) bool {
+		return c.GetConnectionUUID() == connection.GetConnectionUUID()
+	})
+	if i >= 0 {
+		inst.connections[i] = connection
+	} else {
+		inst.connections = append(inst.connections, connection)
+	}
+	inst.setHasConnection(inst.connectionPort(connection))
+}
+
+// NewOutputConnection connects the output to the input of another object in the runtime
+func (inst *BaseObject) NewOutputConnection(portID, targetUUID, targetPort string) error {
+	if inst.GetOutput(portID) == nil {
+		return fmt.Errorf("object: %s output: %s not found", inst.GetUUID(), portID)
+	}
+	if inst.Runtime() == nil {
+		return fmt.Errorf("object: %s has not been added to a runtime", inst.GetUUID())
+	}
+	target := inst.Runtime().GetByUUID(targetUUID)
+	if target == nil {
+		return fmt.Errorf("target object: %s not found", targetUUID)
+	}
+	input := target.GetInput(targetPort)
+	if input == nil {
+		return fmt.Errorf("object: %s input: %s not found", targetUUID, targetPort)
+	}
+	conversion, err := inst.coercions().Resolve(inst.GetOutput(portID).GetDataType(), input.GetDataType())
+	if err != nil {
+		return fmt.Errorf("object: %s output: %s to object: %s input: %s err: %v", inst.GetUUID(), portID, targetUUID, targetPort, err)
+	}
+	publisher, subscriber := NewConnection(inst.GetUUID(), portID, targetUUID, targetPort)
+	publisher.Conversion, subscriber.Conversion = string(conversion), string(conversion)
+	inst.CreateConnection(publisher)
+	target.CreateConnection(subscriber)
+	target.AddSubscriptionConnection(inst.GetUUID(), portID, targetUUID, targetPort)
+	return nil
+}
+
+func (inst *BaseObject) GetPortConnections(portID string) []*runtime.Connection {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	var out []*runtime.Connection
+	for _, c := range inst.connections {
+		if inst.connectionPort(c) == portID {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (inst *BaseObject) GetConnection(uuid string) *runtime.Connection {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	for _, c := range inst.connections {
+		if c.GetConnectionUUID() == uuid {
+			return c
+		}
+	}
+	return nil
+}
+
+func (inst *BaseObject) GetExistingConnection(sourceObjectUUID, targetObjectUUID, targetPortID string) *runtime.Connection {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	for _, c := range inst.connections {
+		if c.GetSourceUUID() == sourceObjectUUID && c.GetTargetUUID() == targetObjectUUID && c.GetTargetPort() == targetPortID {
+			return c
+		}
+	}
+	return nil
+}
+
+func (inst *BaseObject) GetConnections() []*runtime.Connection {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return slices.Clone(inst.connections)
+}
+
+func (inst *BaseObject) PortHasConnection(portID string) (has bool, count int) {
+	count = len(inst.GetPortConnections(portID))
+	return count > 0, count
+}
+
+// RemoveConnection removes the connection, the input is unsubscribed when it was its last connection from the source port
+func (inst *BaseObject) RemoveConnection(connection *runtime.Connection) error {
+	if connection == nil {
+		return errors.New("connection can not be empty")
+	}
+	inst.mutex.Lock()
+	i := slices.IndexFunc(inst.connections, func(c *runtime.Connection) bool {
+		return c.GetConnectionUUID() == connection.GetConnectionUUID()
+	})
+	if i < 0 {
+		inst.mutex.Unlock()
+		return fmt.Errorf("object: %s connection: %s not found", inst.meta.GetObjectUUID(), connection.GetConnectionUUID())
+	}
+	removed := inst.connections[i]
+	inst.connections = slices.Delete(inst.connections, i, i+1)
+	inst.setHasConnection(inst.connectionPort(removed))
+	var keys []string
+	if removed.GetFlowDirection() == DirectionSubscriber {
+		key := subscriptionKey(removed.GetSourceUUID(), removed.GetSourcePort(), removed.GetTargetUUID(), removed.GetTargetPort())
+		if _, ok := inst.subscriptions[key]; ok && !inst.isSubscribed(removed) {
+			delete(inst.subscriptions, key)
+			keys = append(keys, key)
+		}
+	}
+	inst.mutex.Unlock()
+	for _, key := range keys {
+		inst.bus.DeregisterHandler(key)
+	}
+	return nil
+}
+
+// isSubscribed is if another subscriber connection is between the same ports, it must be called with the mutex held
+func (inst *BaseObject) isSubscribed(connection *runtime.Connection) bool {
+	for _, c := range inst.connections {
+		if c.GetFlowDirection() == DirectionSubscriber && c.GetSourceUUID() == connection.GetSourceUUID() &&
+			c.GetSourcePort() == connection.GetSourcePort() && c.GetTargetPort() == connection.GetTargetPort() {
+			return true
+		}
+	}
+	return false
+}
+
+func (inst *BaseObject) DropConnections() []error {
+	var errs []error
+	for _, c := range inst.GetConnections() {
+		if err := inst.RemoveConnection(c); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// RemoveOldConnections removes the connections that are not in the new connections, used on a deploy
+func (inst *BaseObject) RemoveOldConnections(newConnections []*runtime.Connection) []error {
+	keep := make(map[string]bool)
+	for _, c := range newConnections {
+		keep[c.GetConnectionUUID()] = true
+	}
+	var errs []error
+	for _, c := range inst.GetConnections() {
+		if keep[c.GetConnectionUUID()] {
+			continue
+		}
+		if err := inst.RemoveConnection(c); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func subscriptionKey(sourceObjectUUID, sourcePortID, targetObjectUUID, targetPortID string) string {
+	return fmt.Sprintf("%s/%s:%s/%s", sourceObjectUUID, sourcePortID, targetObjectUUID, targetPortID)
+}
+
+// AddSubscriptionConnection subscribes the input to the output of the source object, the current output value is delivered straight away
+func (inst *BaseObject) AddSubscriptionConnection(sourceObjectUUID, sourcePortID, targetObjectUUID, targetPortID string) {
+	key := subscriptionKey(sourceObjectUUID, sourcePortID, targetObjectUUID, targetPortID)
+	inst.mutex.Lock()
+	inst.subscriptions[key] = subscription{sourceUUID: sourceObjectUUID, sourcePort: sourcePortID}
+	inst.mutex.Unlock()
+	handler := bus.Handler{
+		Filter: PortTopic(sourceObjectUUID, sourcePortID),
+		Handle: func(ctx context.Context, e bus.Event) {
+			p, ok := e.Data.(*payload.Payload)
+			if !ok {
+				return
+			}
+			port := inst.GetInput(targetPortID)
+			if port == nil || port.IsDisabled() || port.SubscriptionDisabled() {
+				return
+			}
+			p, err := inst.coerceInput(port, p)
+			if err != nil {
+				log.Printf("object: %s input: %s err: %v", inst.GetUUID(), targetPortID, err)
+				inst.object().SetError(targetPortID, err)
+				return
+			}
+			inst.deliverInput(targetPortID, p)
+		},
+	}
+	if err := inst.bus.RegisterHandlerWithReplay(key, handler, bus.Replay{Latest: true}); err != nil {
+		log.Printf("object: %s input: %s subscribe err: %v", inst.GetUUID(), targetPortID, err)
+	}
+}
+
+// coerceInput converts the payload value to the type of the input, the applied conversion is set on the subscriber connections
+func (inst *BaseObject) coerceInput(port *Port, p *payload.Payload) (*payload.Payload, error) {
+	value, conversion, err := inst.coercions().Coerce(p.PortValue, port.GetDataType())
+	if err != nil {
+		return nil, err
+	}
+	inst.mutex.Lock()
+	for _, c := range inst.connections {
+		if c.GetFlowDirection() == DirectionSubscriber && c.GetSourceUUID() == p.FromObjectUUID &&
+			c.GetSourcePort() == p.FromPortID && c.GetTargetPort() == port.ID {
+			c.Conversion = string(conversion)
+		}
+	}
+	inst.mutex.Unlock()
+	if value == p.PortValue {
+		return p, nil
+	}
+	out := *p
+	out.PortValue = value
+	return &out, nil
+}
+
+func (inst *BaseObject) coercions() *Coercions {
+	if r := inst.Runtime(); r != nil {
+		return orDefaultCoercions(r.Coercions())
+	}
+	return defaultCoercions
+}
+
+// deliverInput updates the input in the mailbox of the object when the runtime has them enabled
+func (inst *BaseObject) deliverInput(portID string, p *payload.Payload) {
+	if r := inst.Runtime(); r != nil {
+		if m := r.Mailbox(inst.GetUUID()); m != nil {
+			if err := m.UpdateInput(portID, p); err != nil {
+				log.Printf("object: %s input: %s err: %v", inst.GetUUID(), portID, err)
+				return
+			}
+			inst.triggerFlow()
+			return
+		}
+	}
+	if err := lifecycleCall(func() error { return inst.updateInput(portID, p) }); err != nil {
+		log.Printf("object: %s input: %s err: %v", inst.GetUUID(), portID, err)
+		inst.object().SetError(portID, err)
+		return
+	}
+	inst.triggerFlow()
+}
+
+// triggerFlow queues the object to be processed by the flow executor after an input changed, it only runs in the event mode
+func (inst *BaseObject) triggerFlow() {
+	r := inst.Runtime()
+	if r == nil || r.Flow() == nil {
+		return
+	}
+	r.Flow().Trigger(inst.GetUUID())
+}
+
+// notifyPortValue tells the change watchers of the runtime the output was published
+func (inst *BaseObject) notifyPortValue(portID string) {
+	r := inst.Runtime()
+	if r == nil {
+		return
+	}
+	r.NotifyPortValue(inst.GetUUID(), portID)
+}
+
+// updateInput updates the input with the payload and calls OnInputUpdated(), the payload PortID is not used
+func (inst *BaseObject) updateInput(portID string, p *payload.Payload) error {
+	if errs := inst.object().UpdateInputsValue(portID, p); len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	inst.object().OnInputUpdated(portID, p)
+	return nil
+}
+
+// -------------------INPUTS------------------
+
+func (inst *BaseObject) GetInput(id string) *Port {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	for _, port := range inst.inputs {
+		if port.ID == id {
+			return port
+		}
+	}
+	return nil
+}
+
+func (inst *BaseObject) InputExists(id string) error {
+	if inst.GetInput(id) == nil {
+		return fmt.Errorf("object: %s input: %s not found", inst.GetUUID(), id)
+	}
+	return nil
+}
+
+func (inst *BaseObject) GetInputs() []*Port {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return slices.Clone(inst.inputs)
+}
+
+func (inst *BaseObject) GetInputByConnection(sourceObjectUUID, outputPortID string) *Port {
+	ports := inst.GetInputByConnections(sourceObjectUUID, outputPortID)
+	if len(ports) == 0 {
+		return nil
+	}
+	return ports[0]
+}
+
+func (inst *BaseObject) GetInputByConnections(sourceObjectUUID, outputPortID string) []*Port {
+	var out []*Port
+	for _, c := range inst.GetConnections() {
+		if c.GetFlowDirection() != DirectionSubscriber || c.GetSourceUUID() != sourceObjectUUID || c.GetSourcePort() != outputPortID {
+			continue
+		}
+		if port := inst.GetInput(c.GetTargetPort()); port != nil && !slices.Contains(out, port) {
+			out = append(out, port)
+		}
+	}
+	return out
+}
+
+// UpdateInputsValue sets the input to a copy of the payload and calls the port OnMessage callback
+func (inst *BaseObject) UpdateInputsValue(portID string, p *payload.Payload) []error {
+	if p == nil || p.PortValue == nil {
+		return []error{fmt.Errorf("object: %s input: %s payload can not be empty", inst.GetUUID(), portID)}
+	}
+	inst.mutex.Lock()
+	var port *Port
+	for _, input := range inst.inputs {
+		if input.ID == portID {
+			port = input
+		}
+	}
+	if port == nil {
+		inst.mutex.Unlock()
+		return []error{fmt.Errorf("object: %s input: %s not found", inst.meta.GetObjectUUID(), portID)}
+	}
+	if port.IsDisabled() {
+		inst.mutex.Unlock()
+		return []error{fmt.Errorf("object: %s input: %s is disabled", inst.meta.GetObjectUUID(), portID)}
+	}
+	value := *p
+	value.PortValue = proto.Clone(p.PortValue).(*runtime.PortValue)
+	value.PortValue.PortID = portID
+	value.PortValue.ObjectUUID = inst.meta.GetObjectUUID()
+	held := port.Payload
+	port.Payload = &value
+	var errs []error
+	if port.UsingTransformation {
+		if err := applyPortTransformation(port); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// an overridden input keeps the override, the new value is restored when it is released
+	if port.holdOverride(port.Payload.PortValue) {
+		port.Payload = held
+		inst.mutex.Unlock()
+		return errs
+	}
+	port.SetLastOk("")
+	onMessage := port.OnMessage
+	persist := port.HasPersistence()
+	inst.mutex.Unlock()
+	if persist {
+		inst.persistPort(portID)
+	}
+	if onMessage != nil {
+		onMessage(portID, &value)
+	}
+	return errs
+}
+
+// persistPort tells the runtime the value of a port with persistence enabled changed, it is saved after the debounce
+func (inst *BaseObject) persistPort(portID string) {
+	r := inst.Runtime()
+	if r == nil || r.Persistence() == nil {
+		return
+	}
+	r.Persistence().PortUpdated(inst.GetUUID(), portID)
+}
+
+func (inst *BaseObject) OnInputUpdated(portID string, payload *payload.Payload) {}
+
+func (inst *BaseObject) SetDynamicInputsCount(count int) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.meta.DynamicInputsCount = int32(count)
+}
+
+func (inst *BaseObject) GetDynamicInputsCount() int {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return int(inst.meta.GetDynamicInputsCount())
+}
+
+// DeleteInput removes the input and its connections
+func (inst *BaseObject) DeleteInput(id string) error {
+	return inst.deletePort(id, Input)
+}
+
+func (inst *BaseObject) deletePort(id string, direction PortDirection) error {
+	for _, c := range inst.GetPortConnections(id) {
+		if err := inst.RemoveConnection(c); err != nil {
+			return err
+		}
+	}
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	ports := &inst.inputs
+	if direction == Output {
+		ports = &inst.outputs
+	}
+	i := slices.IndexFunc(*ports, func(p *Port) bool { return p.ID == id })
+	if i < 0 {
+		return fmt.Errorf("object: %s %s: %s not found", inst.meta.GetObjectUUID(), direction, id)
+	}
+	*ports = slices.Delete(*ports, i, i+1)
+	delete(inst.dynamicInputs, id)
+	return nil
+}
+
+// BuildDynamicInputs adds or removes the float inputs in-0, in-1... to match GetDynamicInputsCount()
+func (inst *BaseObject) BuildDynamicInputs(callback func(portID string, message *payload.Payload)) error {
+	count := inst.GetDynamicInputsCount()
+	if limit := int(inst.getInfo().GetDynamicInputsMaxLimit()); limit > 0 && count > limit {
+		return fmt.Errorf("object: %s dynamic inputs count: %d is more than the max: %d", inst.GetUUID(), count, limit)
+	}
+	for i := 0; i < count; i++ {
+		id := fmt.Sprintf("in-%d", i)
+		if inst.GetInput(id) != nil {
+			continue
+		}
+		if err := inst.NewInputPort(NewPortFloatCallBack(id, callback, &PortOpts{DefaultPosition: i})); err != nil {
+			return err
+		}
+		inst.mutex.Lock()
+		inst.dynamicInputs[id] = i
+		inst.mutex.Unlock()
+	}
+	inst.mutex.RLock()
+	var remove []string
+	for id, i := range inst.dynamicInputs {
+		if i >= count {
+			remove = append(remove, id)
+		}
+	}
+	inst.mutex.RUnlock()
+	var errs []error
+	for _, id := range remove {
+		errs = append(errs, inst.DeleteInput(id))
+	}
+	return errors.Join(errs...)
+}
+
+// -------------------OUTPUTS------------------
+
+func (inst *BaseObject) GetOutputs() []*Port {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return slices.Clone(inst.outputs)
+}
+
+func (inst *BaseObject) GetOutput(id string) *Port {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	for _, port := range inst.outputs {
+		if port.ID == id {
+			return port
+		}
+	}
+	return nil
+}
+
+func (inst *BaseObject) OutputExists(id string) error {
+	if inst.GetOutput(id) == nil {
+		return fmt.Errorf("object: %s output: %s not found", inst.GetUUID(), id)
+	}
+	return nil
+}
+
+// SetOutput sets the output value with the worst quality of the inputs that are connected or have been written, and publishes it;
+// an object without such inputs sets good outputs. With OnlyPublishOnCOV an unchanged value is not published and the Port.Publish
+// options throttle how often it is published
+func (inst *BaseObject) SetOutput(portID string, value any) error {
+	quality, _ := inst.inputsQuality(func(port *Port) bool { return port.HasConnection || port.LastOk != nil })
+	return inst.SetOutputWithQuality(portID, value, quality, time.Time{})
+}
+
+// SetOutputWithQuality sets the output value with its quality and source time, a zero source time is now
+func (inst *BaseObject) SetOutputWithQuality(portID string, value any, quality payload.Quality, sourceTime time.Time) error {
+	inst.mutex.Lock()
+	var port *Port
+	for _, output := range inst.outputs {
+		if output.ID == portID {
+			port = output
+		}
+	}
+	if port == nil {
+		inst.mutex.Unlock()
+		return fmt.Errorf("object: %s output: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	if port.IsDisabled() {
+		inst.mutex.Unlock()
+		return fmt.Errorf("object: %s output: %s is disabled", inst.meta.GetObjectUUID(), portID)
+	}
+	pv, err := newPortValue(port, value)
+	if err != nil {
+		inst.mutex.Unlock()
+		return fmt.Errorf("object: %s output: %s err: %v", inst.meta.GetObjectUUID(), portID, err)
+	}
+	pv.ObjectUUID = inst.meta.GetObjectUUID()
+	now := time.Now()
+	if sourceTime.IsZero() {
+		sourceTime = now
+	}
+	held := port.Payload
+	port.Payload = &payload.Payload{PortValue: pv, Timestamp: now}
+	port.Payload.SetValueQuality(quality).SetSourceTime(sourceTime)
+	if port.UsingTransformation {
+		if err := applyPortTransformation(port); err != nil {
+			port.Payload = held
+			inst.mutex.Unlock()
+			return err
+		}
+	}
+	// an overridden output is not published, the new value is published when it is released
+	if port.holdOverride(port.Payload.PortValue) {
+		port.Payload = held
+		inst.mutex.Unlock()
+		return nil
+	}
+	next := proto.Clone(port.Payload.PortValue).(*runtime.PortValue)
+	persist := port.HasPersistence()
+	policy := newPublishPolicy(port)
+	inst.mutex.Unlock()
+	if persist {
+		inst.persistPort(portID)
+	}
+	if !inst.shouldPublish(portID, policy, next) {
+		return nil
+	}
+	return inst.publishValue(portID, nil)
+}
+
+// sameValue is if the values and their quality are the same, the source timestamps are not compared
+func sameValue(a, b *runtime.PortValue) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	a, b = proto.Clone(a).(*runtime.PortValue), proto.Clone(b).(*runtime.PortValue)
+	a.SourceTimestamp, b.SourceTimestamp = "", ""
+	return proto.Equal(a, b)
+}
+
+// InputsQuality returns the worst quality and the oldest source time of the inputs, or of all the inputs when none are
+// given; an object calculating an output from its inputs can pass them to SetOutputWithQuality()
+func (inst *BaseObject) InputsQuality(portIDs ...string) (payload.Quality, time.Time) {
+	return inst.inputsQuality(func(port *Port) bool { return len(portIDs) == 0 || slices.Contains(portIDs, port.ID) })
+}
+
+// inputsQuality returns the worst quality and the oldest source time of the inputs that match
+func (inst *BaseObject) inputsQuality(match func(port *Port) bool) (payload.Quality, time.Time) {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	now := time.Now()
+	var qualities []payload.Quality
+	var oldest time.Time
+	for _, port := range inst.inputs {
+		if !match(port) {
+			continue
+		}
+		qualities = append(qualities, port.qualityAt(now))
+		if t := port.GetSourceTime(); !t.IsZero() && (oldest.IsZero() || t.Before(oldest)) {
+			oldest = t
+		}
+	}
+	return payload.WorstQuality(qualities...), oldest
+}
+
+// newPortValue converts the value to the data type of the port, a nil value sets the port to nil
+func newPortValue(port *Port, value any) (*runtime.PortValue, error) {
+	pv := &runtime.PortValue{PortID: port.ID, DataType: string(port.DataType)}
+	if value == nil {
+		pv.IsNil = true
+		return pv, nil
+	}
+	dataType := port.GetDataType()
+	if dataType == priority.TypeAny || dataType == "" {
+		switch value.(type) {
+		case bool:
+			dataType = priority.TypeBool
+		case string:
+			dataType = priority.TypeString
+		default:
+			if _, ok := toFloat(value); ok {
+				dataType = priority.TypeFloat
+			} else {
+				dataType = priority.TypeJSON
+			}
+		}
+	}
+	switch dataType {
+	case priority.TypeFloat, priority.TypeInt:
+		f, ok := toFloat(value)
+		if !ok {
+			return nil, fmt.Errorf("value: %v is not a number", value)
+		}
+		if dataType == priority.TypeInt {
+			i := int32(f)
+			pv.IntValue = &i
+		} else {
+			pv.FloatValue = &f
+		}
+	case priority.TypeBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("value: %v is not a bool", value)
+		}
+		pv.BoolValue = &b
+	case priority.TypeString:
+		s, ok := value.(string)
+		if !ok {
+			s = fmt.Sprint(value)
+		}
+		pv.StringValue = &s
+	default:
+		s, ok := value.(string)
+		if !ok {
+			b, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			s = string(b)
+		}
+		pv.JsonValue = &s
+	}
+	return pv, nil
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// DeleteOutput removes the output and its connections
+func (inst *BaseObject) DeleteOutput(id string) error {
+	if err := inst.deletePort(id, Output); err != nil {
+		return err
+	}
+	inst.stopPublishers(id)
+	inst.bus.ClearRetained(PortTopic(inst.GetUUID(), id))
+	return nil
+}
+
+// PublishValue publishes a copy of the output value on PortTopic(), with OnlyPublishOnCOV and the Port.Publish options applied the
+// same as SetOutput(); a value held back by the MinInterval or Debounce is published by a timer
+func (inst *BaseObject) PublishValue(portID string) error {
+	inst.mutex.RLock()
+	var port *Port
+	for _, output := range inst.outputs {
+		if output.ID == portID {
+			port = output
+		}
+	}
+	if port == nil || port.GetPayload() == nil || port.GetPayload().PortValue == nil {
+		inst.mutex.RUnlock()
+		return fmt.Errorf("object: %s output: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	value := proto.Clone(port.GetPayload().PortValue).(*runtime.PortValue)
+	policy := newPublishPolicy(port)
+	inst.mutex.RUnlock()
+	if !inst.shouldPublish(portID, policy, value) {
+		return nil
+	}
+	return inst.publishValue(portID, nil)
+}
+
+// publishValue publishes the output, a timer of the publisher passes it so a stopped publisher is not made again
+func (inst *BaseObject) publishValue(portID string, publisher *publisher) error {
+	inst.mutex.RLock()
+	var port *Port
+	for _, output := range inst.outputs {
+		if output.ID == portID {
+			port = output
+		}
+	}
+	if port == nil || port.GetPayload() == nil || port.GetPayload().PortValue == nil {
+		inst.mutex.RUnlock()
+		return fmt.Errorf("object: %s output: %s not found", inst.meta.GetObjectUUID(), portID)
+	}
+	p := &payload.Payload{
+		FromPortID:     portID,
+		FromObjectUUID: inst.meta.GetObjectUUID(),
+		Timestamp:      port.GetPayload().Timestamp,
+		ExpiresAt:      port.GetPayload().ExpiresAt,
+		PortValue:      proto.Clone(port.GetPayload().PortValue).(*runtime.PortValue),
+	}
+	policy := newPublishPolicy(port)
+	inst.mutex.RUnlock()
+	if p.Timestamp.IsZero() {
+		p.Timestamp = time.Now()
+	}
+	inst.published(portID, policy, publisher, p.PortValue)
+	err := inst.Publish(PortTopic(p.FromObjectUUID, portID), p)
+	inst.notifyPortValue(portID)
+	return err
+}
+
+func (inst *BaseObject) Publish(topic string, data any) error {
+	return inst.bus.Emit(context.Background(), topic, data)
+}
+
+// PublishCommand publishes the command on CommandTopic()
+func (inst *BaseObject) PublishCommand(command *ExtendedCommand) error {
+	if command == nil {
+		return errors.New("command can not be empty")
+	}
+	return inst.Publish(CommandTopic(inst.GetUUID()), command)
+}
+
+// handlerKey is prefixed with the object uuid, so the handler ids only need to be unique for the object
+func (inst *BaseObject) handlerKey(handlerID string) string {
+	key := fmt.Sprintf("%s:%s", inst.GetUUID(), handlerID)
+	inst.mutex.Lock()
+	inst.handlers[key] = true
+	inst.mutex.Unlock()
+	return key
+}
+
+// Subscribe to an eventbus topic, it can be a mqtt style filter; eg Subscribe("ports/+/output", "outputs", callBack)
+func (inst *BaseObject) Subscribe(topic, handlerID string, callBack func(topic string, e bus.Event)) {
+	err := inst.bus.AddHandler(inst.handlerKey(handlerID), bus.Handler{
+		Filter: topic,
+		Handle: func(ctx context.Context, e bus.Event) {
+			callBack(e.Topic, e)
+		},
+	})
+	if err != nil {
+		log.Printf("object: %s subscribe: %s err: %v", inst.GetUUID(), topic, err)
+	}
+}
+
+// SubscribePayload subscribes to the payloads on the topic, see EventbusOpts for the expired payloads and the replay
+func (inst *BaseObject) SubscribePayload(topic, handlerID string, opts *EventbusOpts, callBack func(topic string, p *payload.Payload, err error)) {
+	if opts == nil {
+		opts = &EventbusOpts{}
+	}
+	handler := bus.Handler{
+		Filter: topic,
+		Handle: func(ctx context.Context, e bus.Event) {
+			p, ok := e.Data.(*payload.Payload)
+			if !ok {
+				callBack(e.Topic, nil, fmt.Errorf("topic: %s data is not a payload: %T", e.Topic, e.Data))
+				return
+			}
+			err := p.CheckExpiry(opts.TTL, time.Now())
+			if err != nil && opts.DropExpired {
+				return
+			}
+			callBack(e.Topic, p, err)
+		},
+	}
+	var err error
+	if opts.Replay != nil {
+		err = inst.bus.RegisterHandlerWithReplay(inst.handlerKey(handlerID), handler, *opts.Replay)
+	} else {
+		err = inst.bus.AddHandler(inst.handlerKey(handlerID), handler)
+	}
+	if err != nil {
+		log.Printf("object: %s subscribe: %s err: %v", inst.GetUUID(), topic, err)
+	}
+}
+
+// UnsubscribeConnection unsubscribes the inputs from the output of the source object
+func (inst *BaseObject) UnsubscribeConnection(sourceObjectUUID, sourcePortID string) {
+	inst.mutex.Lock()
+	var keys []string
+	for key, s := range inst.subscriptions {
+		if s.sourceUUID == sourceObjectUUID && s.sourcePort == sourcePortID {
+			keys = append(keys, key)
+			delete(inst.subscriptions, key)
+		}
+	}
+	inst.mutex.Unlock()
+	for _, key := range keys {
+		inst.bus.DeregisterHandler(key)
+	}
+}
+
+// -------------------TREE------------------
+
+// GetRootObject returns the top parent of the object in the runtime
+func (inst *BaseObject) GetRootObject(uuid string) (Object, error) {
+	if inst.Runtime() == nil {
+		return nil, fmt.Errorf("object: %s has not been added to a runtime", inst.GetUUID())
+	}
+	object := inst.Runtime().GetByUUID(uuid)
+	if object == nil {
+		return nil, fmt.Errorf("object: %s not found", uuid)
+	}
+	visited := map[string]bool{uuid: true}
+	for object.GetParentUUID() != "" {
+		parent := inst.Runtime().GetByUUID(object.GetParentUUID())
+		if parent == nil || visited[parent.GetUUID()] {
+			break
+		}
+		visited[parent.GetUUID()] = true
+		object = parent
+	}
+	return object, nil
+}
+
+// PrintObjectTree prints the objects under their parents
+func (inst *BaseObject) PrintObjectTree(objects map[string]Object) {
+	children := make(map[string][]Object)
+	var roots []Object
+	for _, object := range objects {
+		if _, ok := objects[object.GetParentUUID()]; ok {
+			children[object.GetParentUUID()] = append(children[object.GetParentUUID()], object)
+		} else {
+			roots = append(roots, object)
+		}
+	}
+	var print func(objects []Object, depth int)
+	print = func(objects []Object, depth int) {
+		slices.SortFunc(objects, func(a, b Object) int { return strings.Compare(a.GetName(), b.GetName()) })
+		for _, object := range objects {
+			fmt.Printf("%s%s (%s) %s\n", strings.Repeat("  ", depth), object.GetName(), object.GetID(), object.GetUUID())
+			print(children[object.GetUUID()], depth+1)
+		}
+	}
+	print(roots, 0)
+}
+
+// GetCompleteChain returns the object with its parents, root first, and all the objects under it
+func (inst *BaseObject) GetCompleteChain(objects map[string]Object, uuid string) Chain {
+	var chain Chain
+	visited := make(map[string]bool)
+	for object := objects[uuid]; object != nil && !visited[object.GetUUID()]; object = objects[object.GetParentUUID()] {
+		visited[object.GetUUID()] = true
+		chain.RootTreeUUIDs = append([]string{object.GetUUID()}, chain.RootTreeUUIDs...)
+		chain.RootTreeNames = append([]string{object.GetName()}, chain.RootTreeNames...)
+	}
+	parents := []string{uuid}
+	visited = map[string]bool{uuid: true}
+	for len(parents) > 0 {
+		var next []string
+		for _, object := range objects {
+			if visited[object.GetUUID()] || !slices.Contains(parents, object.GetParentUUID()) {
+				continue
+			}
+			visited[object.GetUUID()] = true
+			chain.DescendantTreeUUIDs = append(chain.DescendantTreeUUIDs, object.GetUUID())
+			chain.DescendantTreeNames = append(chain.DescendantTreeNames, object.GetName())
+			next = append(next, object.GetUUID())
+		}
+		parents = next
+	}
+	return chain
+}
+
+// -------------------VALIDATION------------------
+
+func (inst *BaseObject) RunValidation() {}
+
+func (inst *BaseObject) AddValidation(key string) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	if _, ok := inst.validations[key]; !ok {
+		inst.validations[key] = &ErrorsAndValidation{}
+	}
+}
+
+func (inst *BaseObject) DeleteValidation(key string) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	delete(inst.validations, key)
+}
+
+func (inst *BaseObject) GetValidations() map[string]*ErrorsAndValidation {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	out := make(map[string]*ErrorsAndValidation, len(inst.validations))
+	for key, v := range inst.validations {
+		out[key] = v
+	}
+	return out
+}
+
+func (inst *BaseObject) GetValidation(key string) (*ErrorsAndValidation, bool) {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	v, ok := inst.validations[key]
+	return v, ok
+}
+
+func newValidation(m *ValidationMessage) *NewValidation {
+	message := m.Message
+	if message == "" && m.Error != nil {
+		message = m.Error.Error()
+	}
+	return &NewValidation{Message: message, Explanation: m.Explanation, Error: m.Error, Timestamp: time.Now().UTC()}
+}
+
+// SetError sets the error of the key, a nil error deletes it
+func (inst *BaseObject) SetError(key string, err error) {
+	if err == nil {
+		inst.DeleteValidation(key)
+		return
+	}
+	inst.SetValidationError(key, &ValidationMessage{Error: err})
+}
+
+func (inst *BaseObject) SetValidationError(key string, m *ValidationMessage) {
+	inst.setValidation(key, &ErrorsAndValidation{Type: TypeError, ObjectError: newValidation(m)})
+}
+
+// SetHalt sets the halt reason of the key and the object status to halted
+func (inst *BaseObject) SetHalt(key string, m *ValidationMessage) {
+	inst.setValidation(key, &ErrorsAndValidation{Type: TypeHalt, Halt: newValidation(m)})
+	inst.SetStatus(StatsHalted)
+}
+
+func (inst *BaseObject) SetValidation(key string, m *ValidationMessage) {
+	inst.setValidation(key, &ErrorsAndValidation{Type: TypeValidations, Validation: newValidation(m)})
+}
+
+func (inst *BaseObject) setValidation(key string, v *ErrorsAndValidation) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.validations[key] = v
+}
+
+// -------------------STATS------------------
+
+// SetStatus sets the status and tells the runtime watchers if it changed
+func (inst *BaseObject) SetStatus(status ObjectStatus) {
+	inst.mutex.Lock()
+	changed := inst.stats.Status != string(status)
+	inst.stats.Status = string(status)
+	uuid, r := inst.meta.GetObjectUUID(), inst.runtime
+	inst.mutex.Unlock()
+	if changed && r != nil {
+		r.NotifyStatus(uuid, status)
+	}
+}
+
+func (inst *BaseObject) SetLoopCount(count uint) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.stats.LoopCount = uint32(count)
+}
+
+func (inst *BaseObject) GetLoopCount() uint {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return uint(inst.stats.GetLoopCount())
+}
+
+func (inst *BaseObject) IncrementLoopCount() {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.stats.LoopCount++
+}
+
+func (inst *BaseObject) ResetLoopCount() {
+	inst.SetLoopCount(0)
+}
+
+// GetStats returns a copy of the stats, so it can be read while the object is running
+func (inst *BaseObject) GetStats() *runtime.ObjectStats {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return proto.Clone(inst.stats).(*runtime.ObjectStats)
+}
+
+// UpdateStats changes the stats under the object lock, eg; the Process() timings of the flow executor
+func (inst *BaseObject) UpdateStats(update func(stats *runtime.ObjectStats)) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	update(inst.stats)
+}
+
+// -------------------INFO------------------
+
+// SetInfo sets a copy of the info, so the caller can't change the tags or working group without the object being reindexed
+func (inst *BaseObject) SetInfo(info *runtime.Info) {
+	if info == nil {
+		return
+	}
+	info = proto.Clone(info).(*runtime.Info)
+	if info.Requirements == nil {
+		info.Requirements = &runtime.Requirements{}
+	}
+	inst.mutex.Lock()
+	inst.info = info
+	inst.mutex.Unlock()
+	inst.reindex(inst.GetUUID())
+}
+
+// GetInfo returns a copy of the info, the changes are made with SetInfo() and the tag setters
+func (inst *BaseObject) GetInfo() *runtime.Info {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	if inst.info == nil {
+		return nil
+	}
+	return proto.Clone(inst.info).(*runtime.Info)
+}
+
+// getInfo returns the info without a copy for the getters, it is only read; the setters replace it or change the tags and flags under the mutex
+func (inst *BaseObject) getInfo() *runtime.Info {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.info
+}
+
+func (inst *BaseObject) GetID() string {
+	return inst.getInfo().GetObjectID()
+}
+
+func (inst *BaseObject) GetObjectType() ObjectType {
+	return ObjectType(inst.getInfo().GetObjectType())
+}
+
+func (inst *BaseObject) GetUUID() string {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.meta.GetObjectUUID()
+}
+
+func (inst *BaseObject) GetName() string {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.meta.GetObjectName()
+}
+
+func (inst *BaseObject) SetName(v string) string {
+	inst.mutex.Lock()
+	changed := inst.meta.GetObjectName() != v
+	inst.meta.ObjectName = v
+	inst.mutex.Unlock()
+	inst.reindex(inst.GetUUID())
+	if changed {
+		inst.notifyUpdated("meta.objectName")
+	}
+	return v
+}
+
+// notifyUpdated tells the runtime watchers the fields of the object changed
+func (inst *BaseObject) notifyUpdated(fields ...string) {
+	if r := inst.Runtime(); r != nil && len(fields) > 0 {
+		r.NotifyObjectUpdated(inst.GetUUID(), fields...)
+	}
+}
+
+// reindex updates the runtime lookups of the object after its name, parent, category, working group or tags changed;
+// the uuid is the one the object was indexed with, so an object given a new uuid in SetMeta() is moved to it
+func (inst *BaseObject) reindex(uuid string) {
+	if r := inst.Runtime(); r != nil && uuid != "" {
+		r.ReindexObject(uuid)
+	}
+}
+
+func (inst *BaseObject) GetCategory() string {
+	return inst.getInfo().GetCategory()
+}
+
+func (inst *BaseObject) GetWorkingGroup() string {
+	return inst.getInfo().GetWorkingGroup()
+}
+
+func (inst *BaseObject) GetWorkingGroupParent() string {
+	return inst.getInfo().GetWorkingGroupParent()
+}
+
+func (inst *BaseObject) GetWorkingGroupLeader() string {
+	return inst.getInfo().GetWorkingGroupLeader()
+}
+
+// GetWorkingGroupLeaderObjectUUID returns the uuid of the object, or of its first parent, with the working group leader object id
+func (inst *BaseObject) GetWorkingGroupLeaderObjectUUID() string {
+	leader := inst.GetWorkingGroupLeader()
+	if leader == "" {
+		return ""
+	}
+	if inst.GetID() == leader {
+		return inst.GetUUID()
+	}
+	visited := make(map[string]bool)
+	for object := inst.GetParentObject(); object != nil && !visited[object.GetUUID()]; object = inst.Runtime().GetByUUID(object.GetParentUUID()) {
+		if object.GetID() == leader {
+			return object.GetUUID()
+		}
+		visited[object.GetUUID()] = true
+	}
+	return ""
+}
+
+func (inst *BaseObject) GetPluginName() string {
+	return inst.getInfo().GetPluginName()
+}
+
+func (inst *BaseObject) GetMustLiveInObjectType() bool {
+	return inst.GetRequirements().GetMustLiveInObjectType()
+}
+
+func (inst *BaseObject) GetMustLiveParent() bool {
+	return inst.GetRequirements().GetMustLiveParent()
+}
+
+func (inst *BaseObject) GetRequiresLogger() bool {
+	return inst.GetRequirements().GetRequiresLogger()
+}
+
+func (inst *BaseObject) AddLogger(trace *Logger) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.logger = trace
+}
+
+func (inst *BaseObject) Logger() (*Logger, error) {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	if inst.logger == nil {
+		return nil, fmt.Errorf("object: %s has no logger", inst.meta.GetObjectUUID())
+	}
+	return inst.logger, nil
+}
+
+// GetLoggerInfo returns the logger options of the requirements
+func (inst *BaseObject) GetLoggerInfo() ([]string, error) {
+	if !inst.GetRequiresLogger() {
+		return nil, fmt.Errorf("object: %s does not require a logger", inst.GetUUID())
+	}
+	return inst.GetRequirements().GetLoggerOptions(), nil
+}
+
+func (inst *BaseObject) GetSchema() *schema.Generated {
+	return nil
+}
+
+func (inst *BaseObject) GetSettings() *runtime.ObjectSettings {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.settings
+}
+
+func (inst *BaseObject) SetSettings(settings string) error {
+	inst.mutex.Lock()
+	changed := inst.settings.GetValue() != settings
+	inst.settings = &runtime.ObjectSettings{Uuid: inst.meta.GetObjectUUID(), Value: settings}
+	inst.mutex.Unlock()
+	if changed {
+		inst.notifyUpdated("settings")
+	}
+	return nil
+}
+
+// UnmarshalSettings decodes the JSON settings into the target, an object can call it from SetSettings()
+func (inst *BaseObject) UnmarshalSettings(target any) error {
+	value := inst.GetSettings().GetValue()
+	if value == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(value), target)
+}
+
+// GetMeta returns a copy of the meta, the name and parent are changed with SetName() and SetMeta() so the object is reindexed
+func (inst *BaseObject) GetMeta() *runtime.Meta {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return proto.Clone(inst.meta).(*runtime.Meta)
+}
+
+// SetMeta sets a copy of the meta
+func (inst *BaseObject) SetMeta(meta *runtime.Meta) error {
+	if meta == nil {
+		return errors.New("meta can not be empty")
+	}
+	if meta.GetObjectUUID() == "" {
+		return errors.New("meta object uuid can not be empty")
+	}
+	meta = proto.Clone(meta).(*runtime.Meta)
+	if meta.Position == nil {
+		meta.Position = &runtime.Position{}
+	}
+	inst.mutex.Lock()
+	previous := inst.meta
+	inst.meta = meta
+	inst.mutex.Unlock()
+	inst.reindex(previous.GetObjectUUID())
+	var fields []string
+	for _, change := range diffObjectConfig(&runtime.ObjectConfig{Meta: previous}, &runtime.ObjectConfig{Meta: meta}) {
+		fields = append(fields, change.Field)
+	}
+	inst.notifyUpdated(fields...)
+	return nil
+}
+
+func (inst *BaseObject) GetPermissions() *runtime.Permissions {
+	if permissions := inst.getInfo().GetPermissions(); permissions != nil {
+		return proto.Clone(permissions).(*runtime.Permissions)
+	}
+	return nil
+}
+
+func (inst *BaseObject) GetRequirements() *runtime.Requirements {
+	if requirements := inst.getInfo().GetRequirements(); requirements != nil {
+		return proto.Clone(requirements).(*runtime.Requirements)
+	}
+	return nil
+}
+
+// -------------------TAGS------------------
+
+func (inst *BaseObject) AddTag(tag string) {
+	inst.AddTags(tag)
+}
+
+func (inst *BaseObject) AddTags(tags ...string) {
+	inst.mutex.Lock()
+	for _, tag := range tags {
+		if !slices.Contains(inst.info.Tags, tag) {
+			inst.info.Tags = append(inst.info.Tags, tag)
+		}
+	}
+	inst.mutex.Unlock()
+	inst.reindex(inst.GetUUID())
+}
+
+// GetTag returns the tag if the object has it
+func (inst *BaseObject) GetTag(key string) string {
+	if inst.HasTag(key) {
+		return key
+	}
+	return ""
+}
+
+func (inst *BaseObject) HasTag(key string) bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return slices.Contains(inst.info.Tags, key)
+}
+
+func (inst *BaseObject) GetTags() []string {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return slices.Clone(inst.info.Tags)
+}
+
+func (inst *BaseObject) AddMetaTags(key, value string) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	if inst.info.MetaTags == nil {
+		inst.info.MetaTags = make(map[string]string)
+	}
+	inst.info.MetaTags[key] = value
+}
+
+func (inst *BaseObject) GetMetaTag(key string) string {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.info.MetaTags[key]
+}
+
+func (inst *BaseObject) GetMetaTags() map[string]string {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	out := make(map[string]string, len(inst.info.MetaTags))
+	for key, value := range inst.info.MetaTags {
+		out[key] = value
+	}
+	return out
+}
+
+func (inst *BaseObject) HasMetaTag(key string) bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	_, ok := inst.info.MetaTags[key]
+	return ok
+}
+
+func (inst *BaseObject) HasMetaTagValue(key, value string) bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	v, ok := inst.info.MetaTags[key]
+	return ok && v == value
+}
+
+func (inst *BaseObject) AddFlag(key, value string) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	if inst.info.Flags == nil {
+		inst.info.Flags = make(map[string]string)
+	}
+	inst.info.Flags[key] = value
+}
+
+func (inst *BaseObject) GetFlag(key string) string {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.info.Flags[key]
+}
+
+func (inst *BaseObject) GetFlags() map[string]string {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	out := make(map[string]string, len(inst.info.Flags))
+	for key, value := range inst.info.Flags {
+		out[key] = value
+	}
+	return out
+}
+
+func (inst *BaseObject) HasFlag(key string) bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	_, ok := inst.info.Flags[key]
+	return ok
+}
+
+func (inst *BaseObject) HasFlagValue(key, value string) bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	v, ok := inst.info.Flags[key]
+	return ok && v == value
+}
+
+// -------------------CACHE------------------
+
+// SetCache stores the data, it returns an error if the key exists and overwriteExisting is not set
+func (inst *BaseObject) SetCache(key string, data any, expiration time.Duration, overwriteExisting bool) error {
+	if overwriteExisting {
+		inst.cache.Set(key, data, expiration)
+		return nil
+	}
+	return inst.cache.Add(key, data, expiration)
+}
+
+func (inst *BaseObject) GetCache(key string) (data any, found bool) {
+	return inst.cache.Get(key)
+}
+
+func (inst *BaseObject) CacheAll() map[string]cache.Item {
+	return inst.cache.Items()
+}
+
+func (inst *BaseObject) GetHelp() string {
+	return inst.getInfo().GetHelp()
+}
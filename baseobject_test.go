@@ -0,0 +1,257 @@
+package rxlib
+
+import (
+	"errors"
+	"github.com/NubeIO/rxlib/helpers"
+	"github.com/NubeIO/rxlib/libs/bus"
+	"github.com/NubeIO/rxlib/payload"
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
+	"testing"
+	"time"
+)
+
+var _ Object = (*BaseObject)(nil)
+var _ QualityReporter = (*BaseObject)(nil)
+var _ ObjectOverrider = (*BaseObject)(nil)
+var _ StatsUpdater = (*BaseObject)(nil)
+
+// baseAdd only implements Process() and OnInputUpdated(), the rest is from the BaseObject
+type baseAdd struct {
+	*BaseObject
+	updated []float64
+}
+
+func (o *baseAdd) Process() error {
+	return o.SetOutput("out", o.GetInput("in").GetValueFloat()+1)
+}
+
+func (o *baseAdd) OnInputUpdated(portID string, p *payload.Payload) {
+	o.updated = append(o.updated, p.GetFloatValue())
+}
+
+func newTestBus() *bus.Bus {
+	b, _ := bus.NewBus(bus.Next(func() string { return helpers.UUID() }))
+	b.SetAutoRoute(true)
+	b.SetRetainOptions(bus.RetainOptions{Enabled: true})
+	return b
+}
+
+func newBaseAdd(uuid string, b *bus.Bus) *baseAdd {
+	o := &baseAdd{BaseObject: NewBaseObject(&runtime.Info{ObjectID: "add"}, &BaseObjectOpts{Bus: b})}
+	_ = o.SetMeta(&runtime.Meta{ObjectUUID: uuid, ObjectName: uuid})
+	_ = o.NewInputPort(NewPortFloat("in"))
+	_ = o.NewOutputPort(NewPortFloat("out"))
+	return o.New(o).(*baseAdd)
+}
+
+func TestBaseObjectConnections(t *testing.T) {
+	b := newTestBus()
+	a, c := newBaseAdd("a", b), newBaseAdd("c", b)
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{a, c})
+	r.flow = newFlowExecutor(r, nil)
+	if err := a.NewOutputConnection("out", "c", "nope"); err == nil {
+		t.Fatal("expected a connection to a missing input to fail")
+	}
+	if err := a.NewOutputConnection("out", "c", "in"); err != nil {
+		t.Fatal(err)
+	}
+	if has, count := a.PortHasConnection("out"); !has || count != 1 || !c.GetInput("in").HasConnection {
+		t.Fatal("expected the ports to have the connection")
+	}
+	if c.GetInputByConnection("a", "out") != c.GetInput("in") {
+		t.Fatal("expected the input of the connection")
+	}
+
+	// a -> c, processed in order
+	_ = a.InvokePayload(&payload.Payload{PortValue: &runtime.PortValue{PortID: "in", FloatValue: ptr(1.0)}})
+	if errs := r.Flow().RunOnce(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if v := c.GetPortValue("out"); v.GetFloatValue() != 3 || v.GetObjectUUID() != "c" {
+		t.Fatalf("unexpected output: %v", v)
+	}
+	if !slices.Equal(c.updated, []float64{2}) {
+		t.Fatalf("unexpected input updates: %v", c.updated)
+	}
+
+	// a new connection gets the current value, and with COV the same value is not published again
+	d := newBaseAdd("d", b)
+	d.AddSubscriptionConnection("a", "out", "d", "in")
+	if d.GetInput("in").GetValueFloat() != 2 {
+		t.Fatal("expected the retained output value")
+	}
+	a.GetOutput("out").OnlyPublishOnCOV = true
+	_ = a.SetOutput("out", 2.0)
+	_ = a.SetOutput("out", 4)
+	if !slices.Equal(c.updated, []float64{2, 4}) {
+		t.Fatalf("unexpected input updates: %v", c.updated)
+	}
+
+	// deleted objects are unsubscribed
+	if err := c.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	_ = a.SetOutput("out", 5.0)
+	if len(c.updated) != 2 || len(c.GetConnections()) != 0 || c.GetInput("in").HasConnection {
+		t.Fatalf("expected the deleted object to not be updated: %v", c.updated)
+	}
+}
+
+func TestBaseObjectDeploy(t *testing.T) {
+	b := newTestBus()
+	r := &RuntimeImpl{}
+	r.RegisterObject("add", func(config *runtime.ObjectConfig) (Object, error) {
+		return newBaseAdd(config.GetMeta().GetObjectUUID(), b), nil
+	})
+	publisher, subscriber := NewConnection("a", "out", "c", "in")
+	a := deployConfig("add", "a", "", `{"enable":true}`)
+	a.Connections = []*runtime.Connection{publisher}
+	c := deployConfig("add", "c", "", "")
+	c.Connections = []*runtime.Connection{subscriber}
+	if resp := r.Deploy(&Deploy{New: []*runtime.ObjectConfig{a, c}}); !resp.Ok {
+		t.Fatalf("unexpected response: %+v %+v", resp.Results[0], resp.Results[1])
+	}
+	var settings struct{ Enable bool }
+	if err := r.GetByUUID("a").(*baseAdd).UnmarshalSettings(&settings); err != nil || !settings.Enable {
+		t.Fatalf("unexpected settings: %+v %v", settings, err)
+	}
+	if err := r.GetByUUID("a").SetOutput("out", 1.0); err != nil {
+		t.Fatal(err)
+	}
+	if v := r.GetByUUID("c").GetPortValue("in"); v.GetFloatValue() != 1 {
+		t.Fatalf("expected the deployed connection to update the input, got: %v", v)
+	}
+	config := r.GetObjectConfig("c")
+	if len(config.GetInputs()) != 1 || len(config.GetConnections()) != 1 || config.GetMeta().GetObjectName() != "c" {
+		t.Fatalf("unexpected object config: %v", config)
+	}
+}
+
+func TestBaseObjectState(t *testing.T) {
+	o := newBaseAdd("a", newTestBus())
+	o.AddTags("ahu", "ahu", "math")
+	o.AddMetaTags("site", "a")
+	o.AddFlag("debug", "true")
+	if !slices.Equal(o.GetTags(), []string{"ahu", "math"}) || !o.HasMetaTagValue("site", "a") || o.GetFlag("debug") != "true" {
+		t.Fatal("unexpected tags")
+	}
+
+	if err := o.SetCache("a", 1, NoExpiration, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.SetCache("a", 2, NoExpiration, false); err == nil {
+		t.Fatal("expected an existing key to not be overwritten")
+	}
+	if v, ok := o.GetCache("a"); !ok || v != 1 || len(o.CacheAll()) != 1 {
+		t.Fatalf("unexpected cache: %v", v)
+	}
+
+	o.SetError("in", errors.New("bad"))
+	if v, ok := o.GetValidation("in"); !ok || v.Type != TypeError || v.ObjectError.Message != "bad" {
+		t.Fatal("expected the error")
+	}
+	o.SetError("in", nil)
+	o.SetHalt("bus", &ValidationMessage{Message: "no bus"})
+	if _, ok := o.GetValidation("in"); ok || o.GetStats().GetStatus() != string(StatsHalted) {
+		t.Fatal("expected the error to be deleted and the object halted")
+	}
+
+	o.SetDynamicInputsCount(3)
+	_ = o.BuildDynamicInputs(nil)
+	o.SetDynamicInputsCount(1)
+	_ = o.BuildDynamicInputs(nil)
+	var inputs []string
+	for _, port := range o.GetInputs() {
+		inputs = append(inputs, port.GetID())
+	}
+	if !slices.Equal(inputs, []string{"in", "in-0"}) {
+		t.Fatalf("unexpected dynamic inputs: %v", inputs)
+	}
+
+	o.GetOutput("out").EnablePersistence = true
+	if err := o.RestorePersistedValues(&ObjectPersistenceValue{PortID: "out", ValueFloat: ptr(9.0)}); err != nil {
+		t.Fatal(err)
+	}
+	if len(o.PortsWithPersistenceEnabled()) != 1 || o.GetOutput("out").GetValueFloat() != 9 {
+		t.Fatal("expected the persisted value to be restored")
+	}
+	_ = o.NewOutputPort(NewPortAny("any"))
+	if err := o.SetOutput("any", map[string]int{"a": 1}); err != nil || o.GetPortValue("any").GetJsonValue() != `{"a":1}` {
+		t.Fatalf("unexpected any value: %v", err)
+	}
+	_ = o.NewOutputPort(NewPortBool("bool"))
+	if err := o.SetOutput("bool", "x"); err == nil {
+		t.Fatal("expected a string to not be set on a bool output")
+	}
+}
+
+func TestBaseObjectQuality(t *testing.T) {
+	b := newTestBus()
+	a, c := newBaseAdd("a", b), newBaseAdd("c", b)
+	c.GetInput("in").MaxAge = 50 * time.Millisecond
+	c.AddSubscriptionConnection("a", "out", "c", "in")
+	if q := c.GetInput("in").GetQuality(); q != payload.QualityUncertain {
+		t.Fatalf("expected an input without a value to be uncertain, got: %s", q)
+	}
+
+	// the quality and source time are sent with the value
+	read := time.Now().Add(-10 * time.Millisecond)
+	_ = a.SetOutputWithQuality("out", 1.0, payload.QualityCommFault, read)
+	if in := c.GetInput("in"); in.GetQuality() != payload.QualityCommFault || !in.GetSourceTime().Equal(read) || in.IsTrusted() {
+		t.Fatalf("unexpected input quality: %s %v", in.GetQuality(), in.GetSourceTime())
+	}
+	// an input that is not connected and has no value doesn't lower the quality of the outputs
+	_ = a.SetOutput("out", 1.0)
+	if in := c.GetInput("in"); in.GetQuality() != payload.QualityGood || !in.IsTrusted() {
+		t.Fatalf("expected good got: %s", in.GetQuality())
+	}
+	// the output takes the worst quality of the written inputs of the object
+	fault := &payload.Payload{PortValue: &runtime.PortValue{PortID: "in", FloatValue: ptr(0.0)}}
+	fault.SetValueQuality(payload.QualityCommFault)
+	_ = a.InvokePayload(fault)
+	_ = a.SetOutput("out", 1.0)
+	if q := c.GetInput("in").GetQuality(); q != payload.QualityCommFault {
+		t.Fatalf("expected the quality of the written input got: %s", q)
+	}
+	_ = a.InvokePayload(&payload.Payload{PortValue: &runtime.PortValue{PortID: "in", FloatValue: ptr(0.0)}})
+	_ = a.SetOutput("out", 1.0)
+	if q, _ := c.InputsQuality("in"); q != payload.QualityGood {
+		t.Fatalf("expected good got: %s", q)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if q, _ := c.InputsQuality(); q != payload.QualityStale || !c.GetInput("in").IsStale() {
+		t.Fatalf("expected stale got: %s", q)
+	}
+
+	// a value limited by the transformation is out of range
+	c.GetInput("in").Transformation = &priority.Transformations{
+		EnableTransformation: true,
+		ApplyMinMax:          true,
+		MinMaxValue:          &priority.MinMaxValue{MaxValue: ptr(10.0)},
+	}
+	c.GetInput("in").UsingTransformation = true
+	_ = a.SetOutput("out", 20.0)
+	if in := c.GetInput("in"); in.GetValueFloat() != 10 || in.GetQuality() != payload.QualityOutOfRange {
+		t.Fatalf("unexpected input: %v %s", in.GetValueFloat(), in.GetQuality())
+	}
+	if err := c.GetInput("in").SetOverride(5.0); err != nil || c.GetInput("in").GetQuality() != payload.QualityOverridden {
+		t.Fatalf("expected the override quality: %v", err)
+	}
+
+	// with COV only a change of the value or quality is published
+	a.GetOutput("out").OnlyPublishOnCOV = true
+	c.updated = nil
+	_ = a.SetOutput("out", 3.0)
+	_ = a.SetOutput("out", 3.0)
+	_ = a.SetOutputWithQuality("out", 3.0, payload.QualityUncertain, time.Time{})
+	if len(c.updated) != 2 {
+		t.Fatalf("unexpected input updates: %v", c.updated)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,436 @@
+package rxlib
+
+import (
+	"context"
+	"fmt"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"sync"
+	"time"
+)
+
+type ChangeType string
+
+const (
+	ChangeObjectAdded       ChangeType = "object-added"
+	ChangeObjectRemoved     ChangeType = "object-removed"
+	ChangeObjectUpdated     ChangeType = "object-updated" // the settings, meta or ports were changed by a deploy or a setter, see the event fields
+	ChangeConnectionCreated ChangeType = "connection-created"
+	ChangeConnectionRemoved ChangeType = "connection-removed"
+	ChangePortValue         ChangeType = "port-value"
+	ChangeStatus            ChangeType = "status"
+	// ChangeReset is sent when the events after the requested sequence are no longer kept, the client needs to reload the objects and then resume after the sequence of the reset
+	ChangeReset ChangeType = "reset"
+)
+
+const (
+	defaultChangeBufferSize = 1000
+	watcherQueueSize        = 256
+)
+
+type ChangeOpts struct {
+	BufferSize int // how many events are kept so a client can resume after a disconnect; default 1000
+}
+
+// ChangesTopic is the mqtt topic the runtime changes are published on
+func ChangesTopic(globalID string) string {
+	return fmt.Sprintf("ros/api/%s/changes", globalID)
+}
+
+// ChangesReplayTopic is where a client that missed events requests a replay, on ChangesReplayTopic(globalID)/<requestUUID> with a
+// WatchRequest; the events are answered on ChangesReplayTopic(globalID)/<requestUUID>/response
+func ChangesReplayTopic(globalID string) string {
+	return ChangesTopic(globalID) + "/replay"
+}
+
+// MatchChange returns true if the event passes the filters of the watch request
+func MatchChange(req *runtime.WatchRequest, event *runtime.ChangeEvent) bool {
+	if event.GetType() == string(ChangeReset) {
+		return true
+	}
+	if req.GetObjectUUID() != "" && req.GetObjectUUID() != event.GetObjectUUID() {
+		return false
+	}
+	if len(req.GetTypes()) == 0 {
+		return true
+	}
+	for _, t := range req.GetTypes() {
+		if t == event.GetType() {
+			return true
+		}
+	}
+	return false
+}
+
+// changeFeed numbers the runtime changes and keeps the last of them, so a watcher can resume after the sequence of the last event it got
+type changeFeed struct {
+	mutex      sync.Mutex
+	sequence   uint64
+	buffer     []*runtime.ChangeEvent
+	size       int
+	watchers   map[*changeWatcher]struct{}
+	portValues map[string]map[string]*runtime.PortValue // the last port values sent, by object uuid and port id
+	statuses   map[string]ObjectStatus                  // the last status sent, by object uuid
+	deploying  map[string]bool                          // the objects being updated by a deploy, the deploy sends their changes once committed
+}
+
+type changeWatcher struct {
+	req    *runtime.WatchRequest
+	events chan *runtime.ChangeEvent
+	stop   func() bool
+}
+
+func newChangeFeed(opts *ChangeOpts) *changeFeed {
+	if opts == nil {
+		opts = &ChangeOpts{}
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = defaultChangeBufferSize
+	}
+	return &changeFeed{
+		size:       opts.BufferSize,
+		watchers:   make(map[*changeWatcher]struct{}),
+		portValues: make(map[string]map[string]*runtime.PortValue),
+		statuses:   make(map[string]ObjectStatus),
+		deploying:  make(map[string]bool),
+	}
+}
+
+// Watch returns the changes after the req sequence and then the new changes. The channel is closed when the ctx is done, or
+// when the watcher falls behind; the watcher can then Watch again after the sequence of the last event it got
+func (inst *RuntimeImpl) Watch(ctx context.Context, req *runtime.WatchRequest) (<-chan *runtime.ChangeEvent, error) {
+	if inst.changes == nil {
+		return nil, fmt.Errorf("runtime changes are not enabled")
+	}
+	return inst.changes.watch(ctx, req), nil
+}
+
+func (f *changeFeed) watch(ctx context.Context, req *runtime.WatchRequest) <-chan *runtime.ChangeEvent {
+	if req == nil {
+		req = &runtime.WatchRequest{}
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	replay := f.since(req)
+	w := &changeWatcher{
+		req:    req,
+		events: make(chan *runtime.ChangeEvent, len(replay)+watcherQueueSize),
+	}
+	for _, event := range replay {
+		w.events <- event
+	}
+	f.watchers[w] = struct{}{}
+	w.stop = context.AfterFunc(ctx, func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		f.remove(w)
+	})
+	return w.events
+}
+
+// since returns the kept events after the req sequence, or a reset if they are no longer kept; the caller must hold the mutex
+func (f *changeFeed) since(req *runtime.WatchRequest) []*runtime.ChangeEvent {
+	after := req.GetAfterSequence()
+	if after == 0 {
+		return nil
+	}
+	oldest := f.sequence + 1
+	if len(f.buffer) > 0 {
+		oldest = f.buffer[0].GetSequence()
+	}
+	if after > f.sequence || after+1 < oldest {
+		return []*runtime.ChangeEvent{{Sequence: f.sequence, Type: string(ChangeReset), Timestamp: time.Now().UnixMilli()}}
+	}
+	var out []*runtime.ChangeEvent
+	for _, event := range f.buffer {
+		if event.GetSequence() > after && MatchChange(req, event) {
+			out = append(out, event)
+		}
+	}
+	return out
+}
+
+// remove the watcher and close its channel, the caller must hold the mutex
+func (f *changeFeed) remove(w *changeWatcher) {
+	if _, ok := f.watchers[w]; !ok {
+		return
+	}
+	delete(f.watchers, w)
+	close(w.events)
+}
+
+func (f *changeFeed) publish(event *runtime.ChangeEvent) {
+	if f == nil {
+		return
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.sequence++
+	event.Sequence = f.sequence
+	event.Timestamp = time.Now().UnixMilli()
+	f.buffer = append(f.buffer, event)
+	if len(f.buffer) > f.size {
+		f.buffer = append(f.buffer[:0], f.buffer[len(f.buffer)-f.size:]...)
+	}
+	for w := range f.watchers {
+		if !MatchChange(w.req, event) {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			// the watcher fell behind, it resumes with Watch after its last sequence
+			w.stop()
+			f.remove(w)
+		}
+	}
+}
+
+func (f *changeFeed) watching() bool {
+	if f == nil {
+		return false
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.watchers) > 0
+}
+
+// objectsChanged publishes the added and removed objects and stops the mailboxes of the removed objects, it must be called without the runtime mutex held
+func (inst *RuntimeImpl) objectsChanged(added, removed []Object) {
+	inst.mailboxes.remove(removed)
+	inst.overrides.remove(removed)
+	if inst.flow != nil {
+		inst.flow.objectsChanged()
+	}
+	if inst.changes == nil {
+		return
+	}
+	for _, object := range removed {
+		inst.changes.publish(&runtime.ChangeEvent{Type: string(ChangeObjectRemoved), ObjectUUID: object.GetUUID()})
+		inst.changes.mutex.Lock()
+		delete(inst.changes.portValues, object.GetUUID())
+		delete(inst.changes.statuses, object.GetUUID())
+		inst.changes.mutex.Unlock()
+	}
+	for _, object := range added {
+		inst.changes.publish(&runtime.ChangeEvent{Type: string(ChangeObjectAdded), ObjectUUID: object.GetUUID(), Object: inst.serializeObject(false, object)})
+	}
+}
+
+// objectUpdated publishes the fields changed by a deploy, and the connections that were created or removed
+func (inst *RuntimeImpl) objectUpdated(object Object, previous *runtime.ObjectConfig) {
+	if inst.changes == nil {
+		return
+	}
+	config := inst.serializeObject(false, object)
+	var fields []string
+	var connections []*FieldChange
+	for _, change := range diffObjectConfig(previous, config) {
+		if isConnectionChange(change) {
+			connections = append(connections, change)
+		} else {
+			fields = append(fields, change.Field)
+		}
+	}
+	if len(fields) > 0 {
+		inst.changes.publish(&runtime.ChangeEvent{Type: string(ChangeObjectUpdated), ObjectUUID: object.GetUUID(), Object: config, Fields: fields})
+	}
+	for _, change := range connections {
+		if from, ok := change.From.(*runtime.Connection); ok {
+			inst.changes.publish(&runtime.ChangeEvent{Type: string(ChangeConnectionRemoved), ObjectUUID: object.GetUUID(), Connection: from})
+		}
+		if to, ok := change.To.(*runtime.Connection); ok {
+			inst.changes.publish(&runtime.ChangeEvent{Type: string(ChangeConnectionCreated), ObjectUUID: object.GetUUID(), Connection: to})
+		}
+	}
+}
+
+func isConnectionChange(change *FieldChange) bool {
+	_, from := change.From.(*runtime.Connection)
+	_, to := change.To.(*runtime.Connection)
+	return from || to
+}
+
+// NotifyStatus publishes the status of the object to the watchers if it has changed, BaseObject.SetStatus() calls it. The
+// processing status is left out, it is only set while Process() runs so it would send two events each time the object is processed
+func (inst *RuntimeImpl) NotifyStatus(objectUUID string, status ObjectStatus) {
+	if inst.changes == nil || status == StatsProcessing {
+		return
+	}
+	inst.changes.mutex.Lock()
+	if last, ok := inst.changes.statuses[objectUUID]; ok && last == status {
+		inst.changes.mutex.Unlock()
+		return
+	}
+	inst.changes.statuses[objectUUID] = status
+	inst.changes.mutex.Unlock()
+	inst.changes.publish(&runtime.ChangeEvent{Type: string(ChangeStatus), ObjectUUID: objectUUID, Status: string(status)})
+}
+
+// NotifyObjectUpdated publishes the object with the fields that were changed outside a deploy, BaseObject.SetMeta(),
+// SetName() and SetSettings() call it; the changes made by a deploy are sent once the deploy is committed
+func (inst *RuntimeImpl) NotifyObjectUpdated(objectUUID string, fields ...string) {
+	if inst.changes == nil || len(fields) == 0 || inst.changes.isDeploying(objectUUID) {
+		return
+	}
+	object := inst.GetByUUID(objectUUID)
+	if object == nil {
+		return
+	}
+	inst.changes.publish(&runtime.ChangeEvent{Type: string(ChangeObjectUpdated), ObjectUUID: objectUUID, Object: inst.serializeObject(false, object), Fields: fields})
+}
+
+// setDeploying marks the objects as being updated by a deploy, or clears them once it is done
+func (f *changeFeed) setDeploying(objectUUIDs []string, deploying bool) {
+	if f == nil {
+		return
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, uuid := range objectUUIDs {
+		if deploying {
+			f.deploying[uuid] = true
+		} else {
+			delete(f.deploying, uuid)
+		}
+	}
+}
+
+func (f *changeFeed) isDeploying(objectUUID string) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.deploying[objectUUID]
+}
+
+// NotifyPortValue publishes the value of the port to the watchers if it has changed, BaseObject.PublishValue() calls it so the
+// values set by the bus and the overrides are seen
+func (inst *RuntimeImpl) NotifyPortValue(objectUUID, portID string) {
+	if !inst.changes.watching() {
+		return
+	}
+	object := inst.GetByUUID(objectUUID)
+	if object == nil {
+		return
+	}
+	inst.changes.portValue(objectUUID, object.GetPortValue(portID))
+}
+
+// portValuesChanged publishes the port values of the object that have changed since the last time, used after the object is processed
+func (inst *RuntimeImpl) portValuesChanged(object Object) {
+	if !inst.changes.watching() {
+		return
+	}
+	for _, port := range object.GetAllPorts() {
+		inst.changes.portValue(object.GetUUID(), object.GetPortValue(port.ID))
+	}
+}
+
+func (f *changeFeed) portValue(objectUUID string, value *runtime.PortValue) {
+	if value == nil {
+		return
+	}
+	f.mutex.Lock()
+	ports, ok := f.portValues[objectUUID]
+	if !ok {
+		ports = make(map[string]*runtime.PortValue)
+		f.portValues[objectUUID] = ports
+	}
+	if last, ok := ports[value.GetPortID()]; ok && proto.Equal(last, value) {
+		f.mutex.Unlock()
+		return
+	}
+	// a copy, so the object can't change the value after it is sent
+	value, _ = proto.Clone(value).(*runtime.PortValue)
+	ports[value.GetPortID()] = value
+	f.mutex.Unlock()
+	f.publish(&runtime.ChangeEvent{Type: string(ChangePortValue), ObjectUUID: objectUUID, PortValue: value})
+}
+
+// forwardChanges sends the changes until the ctx is done or send returns an err, a watcher that falls behind resumes after its last sequence
+func (inst *RuntimeImpl) forwardChanges(ctx context.Context, req *runtime.WatchRequest, send func(event *runtime.ChangeEvent) error) error {
+	if inst.changes == nil {
+		return fmt.Errorf("runtime changes are not enabled")
+	}
+	req, _ = proto.Clone(req).(*runtime.WatchRequest)
+	if req == nil {
+		req = &runtime.WatchRequest{}
+	}
+	if req.AfterSequence == 0 {
+		// only the new events, so after falling behind the watcher resumes from here
+		inst.changes.mutex.Lock()
+		req.AfterSequence = inst.changes.sequence
+		inst.changes.mutex.Unlock()
+	}
+	for {
+		events, err := inst.Watch(ctx, req)
+		if err != nil {
+			return err
+		}
+		for event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+			req.AfterSequence = event.GetSequence()
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// WatchChanges streams the changes over grpc, it has the signature of the RuntimeServiceServer so the grpc server can call it
+func (inst *RuntimeImpl) WatchChanges(req *runtime.WatchRequest, stream runtime.RuntimeService_WatchChangesServer) error {
+	return inst.forwardChanges(stream.Context(), req, stream.Send)
+}
+
+// PublishChanges publishes the changes on the ChangesTopic over mqtt, and answers the replay requests of the clients that missed events; until the ctx is done
+func (inst *RuntimeImpl) PublishChanges(ctx context.Context) error {
+	if inst.mqttClient == nil || inst.runtimeSettings == nil {
+		return fmt.Errorf("publish changes: mqtt client and runtime settings are required")
+	}
+	topic := ChangesTopic(inst.runtimeSettings.GlobalID)
+	requests := ChangesReplayTopic(inst.runtimeSettings.GlobalID) + "/+"
+	err := inst.mqttClient.Subscribe(requests, func(requestTopic string, body []byte) {
+		if err := inst.replayChanges(requestTopic, body); err != nil {
+			log.Printf("changes replay: %v", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer inst.mqttClient.Unsubscribe(requests)
+		err := inst.forwardChanges(ctx, nil, func(event *runtime.ChangeEvent) error {
+			b, err := protojson.Marshal(event)
+			if err != nil {
+				return err
+			}
+			if err := inst.mqttClient.Publish(topic, b); err != nil {
+				log.Printf("changes publish: %v", err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("changes publish: %v", err)
+		}
+	}()
+	return nil
+}
+
+// replayChanges answers a replay request with the kept events after the requested sequence
+func (inst *RuntimeImpl) replayChanges(requestTopic string, body []byte) error {
+	req := &runtime.WatchRequest{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, req); err != nil {
+		return err
+	}
+	inst.changes.mutex.Lock()
+	events := &runtime.ChangeEvents{Events: inst.changes.since(req)}
+	inst.changes.mutex.Unlock()
+	b, err := protojson.Marshal(events)
+	if err != nil {
+		return err
+	}
+	return inst.mqttClient.Publish(requestTopic+"/response", b)
+}
@@ -0,0 +1,353 @@
+package rxlib
+
+import (
+	"context"
+	"errors"
+	"github.com/NubeIO/mqttwrapper"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// portObject has one output, its value is set by the test
+type portObject struct {
+	testObject
+	value float64
+}
+
+func (o *portObject) GetAllPorts() []*Port { return []*Port{{ID: "out"}} }
+func (o *portObject) GetPortValue(portID string) *runtime.PortValue {
+	return &runtime.PortValue{ObjectUUID: o.uuid, PortID: portID, FloatValue: &o.value}
+}
+
+// watchStream is the grpc server side of WatchChanges
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *runtime.ChangeEvent
+}
+
+func (s *watchStream) Context() context.Context { return s.ctx }
+func (s *watchStream) Send(event *runtime.ChangeEvent) error {
+	s.events <- event
+	return nil
+}
+
+func receive(t *testing.T, events <-chan *runtime.ChangeEvent, count int) []*runtime.ChangeEvent {
+	t.Helper()
+	var out []*runtime.ChangeEvent
+	for len(out) < count {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatalf("the watcher was closed after %d of %d events", len(out), count)
+			}
+			out = append(out, event)
+		case <-time.After(time.Second):
+			t.Fatalf("timeout after %d of %d events", len(out), count)
+		}
+	}
+	return out
+}
+
+func changeTypes(events []*runtime.ChangeEvent) []string {
+	var out []string
+	for _, event := range events {
+		out = append(out, event.GetType())
+	}
+	return out
+}
+
+func TestWatchChanges(t *testing.T) {
+	r := &RuntimeImpl{changes: newChangeFeed(&ChangeOpts{BufferSize: 2})}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	all, err := r.Watch(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses, _ := r.Watch(ctx, &runtime.WatchRequest{Types: []string{string(ChangeStatus)}, ObjectUUID: "b"})
+
+	r.AddObject(&deployObject{testObject: testObject{uuid: "a", id: "add"}})
+	r.NotifyStatus("a", StatsLoaded)
+	r.NotifyStatus("b", StatsError)
+	if err := r.DeleteByUUID("a"); err != nil {
+		t.Fatal(err)
+	}
+	events := receive(t, all, 4)
+	if got := changeTypes(events); !slices.Equal(got, []string{"object-added", "status", "status", "object-removed"}) {
+		t.Fatalf("unexpected events: %v", got)
+	}
+	for i, event := range events {
+		if event.GetSequence() != uint64(i+1) {
+			t.Fatalf("unexpected sequence: %d", event.GetSequence())
+		}
+	}
+	if event := receive(t, statuses, 1)[0]; event.GetObjectUUID() != "b" || event.GetStatus() != string(StatsError) {
+		t.Fatalf("unexpected filtered event: %v", event)
+	}
+
+	// resume after the second event, only the last 2 are kept
+	resumed, _ := r.Watch(ctx, &runtime.WatchRequest{AfterSequence: 2})
+	if got := receive(t, resumed, 2); got[0].GetSequence() != 3 || got[1].GetSequence() != 4 {
+		t.Fatalf("unexpected replay: %v", got)
+	}
+	fromNow, _ := r.Watch(ctx, &runtime.WatchRequest{})
+	select {
+	case event := <-fromNow:
+		t.Fatalf("expected no replay, got: %v", event)
+	default:
+	}
+	for _, after := range []uint64{1, 100} {
+		// the events after 1 are no longer kept, and 100 is from before a restart
+		reset, _ := r.Watch(ctx, &runtime.WatchRequest{AfterSequence: after})
+		if event := receive(t, reset, 1)[0]; event.GetType() != string(ChangeReset) || event.GetSequence() != 4 {
+			t.Fatalf("expected a reset after: %d, got: %v", after, event)
+		}
+	}
+
+	cancel()
+	if _, ok := <-all; ok {
+		t.Fatal("expected the watcher to be closed when the ctx is done")
+	}
+}
+
+func TestWatchChangesSlowWatcher(t *testing.T) {
+	r := &RuntimeImpl{changes: newChangeFeed(nil)}
+	slow, _ := r.Watch(context.Background(), nil)
+	for i := 0; i <= watcherQueueSize; i++ {
+		// an unchanged status is not sent, so it alternates
+		r.NotifyStatus("a", []ObjectStatus{StatsIdle, StatsError}[i%2])
+	}
+	count := 0
+	for range slow {
+		count++
+	}
+	if count != watcherQueueSize || r.changes.watching() {
+		t.Fatalf("expected the slow watcher to be dropped after %d events, got: %d", watcherQueueSize, count)
+	}
+	// it resumes after the last event it got
+	resumed, _ := r.Watch(context.Background(), &runtime.WatchRequest{AfterSequence: uint64(count)})
+	if event := receive(t, resumed, 1)[0]; event.GetSequence() != uint64(count+1) {
+		t.Fatalf("unexpected resume: %v", event)
+	}
+}
+
+func TestWatchDeployChanges(t *testing.T) {
+	r, _ := newDeployRuntime()
+	r.changes = newChangeFeed(nil)
+	events, _ := r.Watch(context.Background(), nil)
+	add := deployConfig("add", "add", "", "{}")
+	publisher, subscriber := NewConnection("counter", "out", "add", "in")
+	add.Connections = []*runtime.Connection{subscriber}
+	counter := deployConfig("count", "counter", "", "b")
+	counter.Connections = []*runtime.Connection{publisher}
+
+	resp := r.Deploy(&Deploy{
+		New:     []*runtime.ObjectConfig{add},
+		Updated: []*runtime.ObjectConfig{counter},
+		Deleted: []string{"device"},
+	})
+	if !resp.Ok {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	got := receive(t, events, 4)
+	if types := changeTypes(got); !slices.Equal(types, []string{"object-removed", "object-added", "object-updated", "connection-created"}) {
+		t.Fatalf("unexpected events: %v", types)
+	}
+	if got[1].GetObject().GetMeta().GetObjectUUID() != "add" || !slices.Equal(got[2].GetFields(), []string{"meta.objectName", "settings"}) {
+		t.Fatalf("unexpected events: %v", got)
+	}
+	if got[3].GetConnection().GetConnectionUUID() != publisher.GetConnectionUUID() {
+		t.Fatalf("unexpected connection: %v", got[3].GetConnection())
+	}
+
+	// a failed deploy is rolled back, so the watchers see nothing
+	r.GetByUUID("network").(*deployObject).settingsErr = errors.New("bad settings")
+	if resp := r.Deploy(&Deploy{Updated: []*runtime.ObjectConfig{deployConfig("network", "network", "", "b")}}); resp.Ok {
+		t.Fatal("expected the deploy to fail")
+	}
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event: %v", event)
+	default:
+	}
+}
+
+func TestWatchObjectChanges(t *testing.T) {
+	a := newBaseAdd("a", newTestBus())
+	r := &RuntimeImpl{changes: newChangeFeed(nil)}
+	r.AddObjects([]Object{a})
+	events, _ := r.Watch(context.Background(), nil)
+	noEvent := func() {
+		t.Helper()
+		select {
+		case event := <-events:
+			t.Fatalf("unexpected event: %v", event)
+		default:
+		}
+	}
+
+	a.SetStatus(StatsError)
+	if event := receive(t, events, 1)[0]; event.GetType() != string(ChangeStatus) || event.GetStatus() != string(StatsError) {
+		t.Fatalf("unexpected event: %v", event)
+	}
+	a.SetStatus(StatsError)      // not changed
+	a.SetStatus(StatsProcessing) // only set while Process() runs
+	noEvent()
+
+	_ = a.SetSettings(`{"x":1}`)
+	a.SetName("sum")
+	got := receive(t, events, 2)
+	if !slices.Equal(got[0].GetFields(), []string{"settings"}) || !slices.Equal(got[1].GetFields(), []string{"meta.objectName"}) {
+		t.Fatalf("unexpected events: %v", got)
+	}
+	if got[1].GetObject().GetMeta().GetObjectName() != "sum" {
+		t.Fatalf("unexpected object: %v", got[1].GetObject())
+	}
+
+	// a deploy sends its changes once, when it is committed
+	config := r.GetObjectsConfig()[0]
+	config.Settings = &runtime.ObjectSettings{Value: `{"x":2}`}
+	if resp := r.Deploy(&Deploy{Updated: []*runtime.ObjectConfig{config}}); !resp.Ok {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if event := receive(t, events, 1)[0]; !slices.Equal(event.GetFields(), []string{"settings"}) {
+		t.Fatalf("unexpected event: %v", event)
+	}
+	noEvent()
+}
+
+func TestWatchPortValues(t *testing.T) {
+	object := &portObject{testObject: testObject{uuid: "a"}, value: 1}
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{object})
+	r.changes = newChangeFeed(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &watchStream{ctx: ctx, events: make(chan *runtime.ChangeEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- r.WatchChanges(&runtime.WatchRequest{Types: []string{string(ChangePortValue)}}, stream)
+	}()
+	for !r.changes.watching() {
+		time.Sleep(time.Millisecond)
+	}
+
+	r.portValuesChanged(object)
+	r.portValuesChanged(object) // not changed
+	object.value = 2
+	r.NotifyPortValue("a", "out")
+	got := receive(t, stream.events, 2)
+	if got[0].GetPortValue().GetFloatValue() != 1 || got[1].GetPortValue().GetFloatValue() != 2 {
+		t.Fatalf("unexpected port values: %v", got)
+	}
+
+	// a published output is seen without the flow executor
+	add := newBaseAdd("add", newTestBus())
+	r.AddObjects([]Object{add})
+	_ = add.SetOutput("out", 5.0)
+	if got := receive(t, stream.events, 1); got[0].GetObjectUUID() != "add" || got[0].GetPortValue().GetFloatValue() != 5 {
+		t.Fatalf("unexpected port value: %v", got)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fakeMQTT delivers a publish to the subscriptions with a matching topic, + matches one level
+type fakeMQTT struct {
+	mqttwrapper.MQTT
+	mutex        sync.Mutex
+	handlers     map[string]mqttwrapper.SubscribeHandleFunction
+	unsubscribed []string
+}
+
+func (m *fakeMQTT) Subscribe(topic string, fnc mqttwrapper.SubscribeHandleFunction) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.handlers[topic] = fnc
+	return nil
+}
+func (m *fakeMQTT) Unsubscribe(topic string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.handlers, topic)
+	m.unsubscribed = append(m.unsubscribed, topic)
+	return nil
+}
+func (m *fakeMQTT) Publish(topic string, body interface{}) error {
+	var matched []mqttwrapper.SubscribeHandleFunction
+	m.mutex.Lock()
+	for filter, fnc := range m.handlers {
+		if topicMatches(filter, topic) {
+			matched = append(matched, fnc)
+		}
+	}
+	m.mutex.Unlock()
+	for _, fnc := range matched {
+		fnc(topic, body.([]byte))
+	}
+	return nil
+}
+func (m *fakeMQTT) getUnsubscribed() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return slices.Clone(m.unsubscribed)
+}
+
+func topicMatches(filter, topic string) bool {
+	filters, levels := strings.Split(filter, "/"), strings.Split(topic, "/")
+	if len(filters) != len(levels) {
+		return false
+	}
+	for i := range filters {
+		if filters[i] != "+" && filters[i] != levels[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPublishChangesReplay(t *testing.T) {
+	client := &fakeMQTT{handlers: make(map[string]mqttwrapper.SubscribeHandleFunction)}
+	r := &RuntimeImpl{changes: newChangeFeed(nil), mqttClient: client, runtimeSettings: &RuntimeSettings{GlobalID: "rt"}}
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := r.PublishChanges(ctx); err != nil {
+		t.Fatal(err)
+	}
+	client.mutex.Lock()
+	_, subscribed := client.handlers[ChangesReplayTopic("rt")+"/+"]
+	count := len(client.handlers)
+	client.mutex.Unlock()
+	if !subscribed || count != 1 {
+		t.Fatal("expected the runtime to subscribe to the replay requests only")
+	}
+	r.NotifyStatus("a", StatsLoaded)
+	r.NotifyStatus("b", StatsLoaded)
+
+	responses := make(chan []byte, 1)
+	_ = client.Subscribe(ChangesReplayTopic("rt")+"/abc/response", func(topic string, body []byte) { responses <- body })
+	_ = client.Publish(ChangesReplayTopic("rt")+"/abc", []byte(`{"afterSequence": "1"}`))
+	select {
+	case body := <-responses:
+		events := &runtime.ChangeEvents{}
+		if err := protojson.Unmarshal(body, events); err != nil || len(events.GetEvents()) != 1 || events.GetEvents()[0].GetObjectUUID() != "b" {
+			t.Fatalf("unexpected replay: %s %v", body, err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for the replay")
+	}
+
+	cancel()
+	waitFor(t, func() bool { return len(client.getUnsubscribed()) > 0 })
+	if got := client.getUnsubscribed(); !slices.Equal(got, []string{ChangesReplayTopic("rt") + "/+"}) {
+		t.Fatalf("expected only the replay topic to be unsubscribed got: %v", got)
+	}
+}
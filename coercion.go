@@ -0,0 +1,263 @@
+package rxlib
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/NubeIO/rxlib/libs/convert"
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Conversion is the conversion applied to the values of a connection, it is set on runtime.Connection.Conversion; eg "bool-to-float"
+type Conversion string
+
+const (
+	// ConversionNone the ports are the same type, or the input is TypeAny
+	ConversionNone Conversion = ""
+	// ConversionUnsupported the types have no rule and the coercions are not strict, so the values are passed as they are
+	ConversionUnsupported Conversion = "unsupported"
+)
+
+func NewConversion(from, to priority.Type) Conversion {
+	if from == to || from == "" || to == "" || to == priority.TypeAny {
+		return ConversionNone
+	}
+	return Conversion(fmt.Sprintf("%s-to-%s", from, to))
+}
+
+// CoerceFunc converts the value of an output to the type of the input
+type CoerceFunc func(value *runtime.PortValue, to priority.Type) (*runtime.PortValue, error)
+
+// Coercions is the matrix of the conversions allowed between the output and input types of a connection
+type Coercions struct {
+	mutex  sync.RWMutex
+	strict bool
+	rules  map[Conversion]CoerceFunc
+}
+
+// NewCoercions returns the default rules, when strict a connection between types that have no rule is rejected when it is created
+//
+// the numbers and bools convert to each other, everything converts to a string and a string to anything (which can fail on the
+// value), the numbers, bools and strings convert to json and an any output converts to anything
+func NewCoercions(strict bool) *Coercions {
+	c := &Coercions{strict: strict, rules: make(map[Conversion]CoerceFunc)}
+	scalars := []priority.Type{priority.TypeFloat, priority.TypeInt, priority.TypeBool}
+	for _, from := range scalars {
+		for _, to := range scalars {
+			c.Allow(from, to, nil)
+		}
+		c.Allow(from, priority.TypeJSON, nil)
+	}
+	for _, t := range append(scalars, priority.TypeJSON, priority.TypeDate) {
+		c.Allow(t, priority.TypeString, nil)
+		c.Allow(priority.TypeString, t, nil)
+	}
+	for _, to := range append(scalars, priority.TypeString, priority.TypeJSON, priority.TypeDate) {
+		c.Allow(priority.TypeAny, to, nil)
+	}
+	return c
+}
+
+// defaultCoercions are used when the object has no runtime, they are never returned so they can't be changed
+var defaultCoercions = NewCoercions(false)
+
+func (inst *Coercions) Strict() bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.strict
+}
+
+func (inst *Coercions) SetStrict(strict bool) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.strict = strict
+}
+
+// Allow adds or replaces the rule, if fn is nil the values are converted with CoerceValue()
+func (inst *Coercions) Allow(from, to priority.Type, fn CoerceFunc) {
+	conversion := NewConversion(from, to)
+	if conversion == ConversionNone {
+		return
+	}
+	if fn == nil {
+		fn = CoerceValue
+	}
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	inst.rules[conversion] = fn
+}
+
+// Deny removes the rule
+func (inst *Coercions) Deny(from, to priority.Type) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	delete(inst.rules, NewConversion(from, to))
+}
+
+// Resolve returns the conversion of a connection from an output to an input, it errors when strict and there is no rule
+func (inst *Coercions) Resolve(from, to priority.Type) (Conversion, error) {
+	conversion := NewConversion(from, to)
+	if conversion == ConversionNone {
+		return ConversionNone, nil
+	}
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	if _, ok := inst.rules[conversion]; ok {
+		return conversion, nil
+	}
+	if inst.strict {
+		return ConversionNone, fmt.Errorf("a %s output can not be connected to a %s input", from, to)
+	}
+	return ConversionUnsupported, nil
+}
+
+// Coerce converts the value to the input type, the value is returned as it is when there is nothing to convert
+func (inst *Coercions) Coerce(value *runtime.PortValue, to priority.Type) (*runtime.PortValue, Conversion, error) {
+	if value == nil {
+		return nil, ConversionNone, nil
+	}
+	conversion, err := inst.Resolve(priority.Type(value.GetDataType()), to)
+	if err != nil || conversion == ConversionNone || conversion == ConversionUnsupported {
+		return value, conversion, err
+	}
+	inst.mutex.RLock()
+	fn := inst.rules[conversion]
+	inst.mutex.RUnlock()
+	if value.GetIsNil() {
+		out := proto.Clone(value).(*runtime.PortValue)
+		out.DataType = string(to)
+		return out, conversion, nil
+	}
+	out, err := fn(value, to)
+	if err != nil {
+		return nil, conversion, fmt.Errorf("%s: %v", conversion, err)
+	}
+	out.DataType = string(to)
+	return out, conversion, nil
+}
+
+// CoerceValue is the default CoerceFunc, it reads whichever value is set and writes it to the field of the type
+func CoerceValue(value *runtime.PortValue, to priority.Type) (*runtime.PortValue, error) {
+	out := proto.Clone(value).(*runtime.PortValue)
+	out.FloatValue, out.IntValue, out.BoolValue, out.StringValue, out.JsonValue = nil, nil, nil, nil, nil
+	v, ok := portValueAny(value)
+	if !ok {
+		out.IsNil = true
+		return out, nil
+	}
+	switch to {
+	case priority.TypeFloat, priority.TypeInt:
+		f, err := coerceFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		if to == priority.TypeInt {
+			i := int32(f)
+			out.IntValue = &i
+		} else {
+			out.FloatValue = &f
+		}
+	case priority.TypeBool:
+		b, err := coerceBool(v)
+		if err != nil {
+			return nil, err
+		}
+		out.BoolValue = &b
+	case priority.TypeString, priority.TypeDate:
+		s := coerceString(v)
+		out.StringValue = &s
+	case priority.TypeJSON:
+		s, ok := v.(string)
+		if !ok || !json.Valid([]byte(s)) {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			s = string(b)
+		}
+		out.JsonValue = &s
+	default:
+		return nil, fmt.Errorf("can not convert to type: %s", to)
+	}
+	return out, nil
+}
+
+// portValueAny returns the value that is set, the json value is returned as a string
+func portValueAny(value *runtime.PortValue) (any, bool) {
+	switch {
+	case value.FloatValue != nil:
+		return value.GetFloatValue(), true
+	case value.IntValue != nil:
+		return value.GetIntValue(), true
+	case value.BoolValue != nil:
+		return value.GetBoolValue(), true
+	case value.StringValue != nil:
+		return value.GetStringValue(), true
+	case value.JsonValue != nil:
+		return value.GetJsonValue(), true
+	}
+	return nil, false
+}
+
+func coerceFloat(v any) (float64, error) {
+	switch value := v.(type) {
+	case float64:
+		return value, nil
+	case int32:
+		return float64(value), nil
+	case bool:
+		return convert.BoolToFloat(value), nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0, fmt.Errorf("value: %q is not a number", value)
+		}
+		return f, nil
+	}
+	return 0, fmt.Errorf("value: %v is not a number", v)
+}
+
+func coerceBool(v any) (bool, error) {
+	switch value := v.(type) {
+	case bool:
+		return value, nil
+	case float64:
+		return convert.FloatToBool(value), nil
+	case int32:
+		return convert.IntToBool(int(value)), nil
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return false, fmt.Errorf("value: %q is not a bool", value)
+		}
+		return b, nil
+	}
+	return false, fmt.Errorf("value: %v is not a bool", v)
+}
+
+func coerceString(v any) string {
+	switch value := v.(type) {
+	case float64:
+		return convert.FloatToString(value)
+	case bool:
+		return convert.BoolToString(value)
+	}
+	return fmt.Sprint(v)
+}
+
+// Coercions returns RuntimeOpts.Coercions, or the non strict rules NewRuntime() built for the runtime
+func (inst *RuntimeImpl) Coercions() *Coercions {
+	return inst.coercions
+}
+
+// orDefaultCoercions returns the default rules for a runtime that has none, eg; one not built with NewRuntime()
+func orDefaultCoercions(c *Coercions) *Coercions {
+	if c == nil {
+		return defaultCoercions
+	}
+	return c
+}
@@ -0,0 +1,171 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/libs/convert"
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"testing"
+)
+
+func TestCoercionsResolve(t *testing.T) {
+	c := NewCoercions(false)
+	tests := []struct {
+		from, to priority.Type
+		expected Conversion
+	}{
+		{priority.TypeFloat, priority.TypeFloat, ConversionNone},
+		{priority.TypeJSON, priority.TypeAny, ConversionNone},
+		{priority.TypeBool, priority.TypeFloat, "bool-to-float"},
+		{priority.TypeString, priority.TypeInt, "string-to-int"},
+		{priority.TypeAny, priority.TypeBool, "any-to-bool"},
+		{priority.TypeJSON, priority.TypeFloat, ConversionUnsupported},
+	}
+	for _, test := range tests {
+		if conversion, err := c.Resolve(test.from, test.to); err != nil || conversion != test.expected {
+			t.Errorf("%s to %s: expected %q got %q %v", test.from, test.to, test.expected, conversion, err)
+		}
+	}
+
+	c.SetStrict(true)
+	if _, err := c.Resolve(priority.TypeJSON, priority.TypeFloat); err == nil {
+		t.Fatal("expected strict coercions to reject json to float")
+	}
+	c.Deny(priority.TypeBool, priority.TypeFloat)
+	if _, err := c.Resolve(priority.TypeBool, priority.TypeFloat); err == nil {
+		t.Fatal("expected the denied conversion to be rejected")
+	}
+	c.Allow(priority.TypeJSON, priority.TypeFloat, func(value *runtime.PortValue, to priority.Type) (*runtime.PortValue, error) {
+		return &runtime.PortValue{FloatValue: ptr(float64(len(value.GetJsonValue())))}, nil
+	})
+	v, conversion, err := c.Coerce(&runtime.PortValue{DataType: priority.TypeJSON, JsonValue: ptr(`{}`)}, priority.TypeFloat)
+	if err != nil || conversion != "json-to-float" || v.GetFloatValue() != 2 || v.GetDataType() != priority.TypeFloat {
+		t.Fatalf("unexpected custom conversion: %v %s %v", v, conversion, err)
+	}
+	// each runtime has its own rules
+	r1, r2 := &RuntimeImpl{coercions: NewCoercions(false)}, &RuntimeImpl{coercions: NewCoercions(false)}
+	r1.Coercions().SetStrict(true)
+	if r1.Coercions() == r2.Coercions() || r2.Coercions().Strict() || defaultCoercions.Strict() {
+		t.Fatal("expected the rules of a runtime to be its own")
+	}
+}
+
+func TestCoerceValue(t *testing.T) {
+	c := NewCoercions(false)
+	tests := []struct {
+		value    *runtime.PortValue
+		to       priority.Type
+		expected string
+	}{
+		{&runtime.PortValue{DataType: priority.TypeBool, BoolValue: ptr(true)}, priority.TypeFloat, "1"},
+		{&runtime.PortValue{DataType: priority.TypeFloat, FloatValue: ptr(2.5)}, priority.TypeInt, "2"},
+		{&runtime.PortValue{DataType: priority.TypeFloat, FloatValue: ptr(0.0)}, priority.TypeBool, "false"},
+		{&runtime.PortValue{DataType: priority.TypeFloat, FloatValue: ptr(2.5)}, priority.TypeString, "2.5"},
+		{&runtime.PortValue{DataType: priority.TypeString, StringValue: ptr(" 12 ")}, priority.TypeFloat, "12"},
+		{&runtime.PortValue{DataType: priority.TypeString, StringValue: ptr("abc")}, priority.TypeJSON, `"abc"`},
+		{&runtime.PortValue{DataType: priority.TypeString, StringValue: ptr(`{"a":1}`)}, priority.TypeJSON, `{"a":1}`},
+		{&runtime.PortValue{DataType: priority.TypeAny, BoolValue: ptr(true)}, priority.TypeString, "true"},
+	}
+	for _, test := range tests {
+		v, _, err := c.Coerce(test.value, test.to)
+		if err != nil {
+			t.Errorf("%v to %s: %v", test.value, test.to, err)
+			continue
+		}
+		var got string
+		switch test.to {
+		case priority.TypeFloat:
+			got = convert.FloatToString(v.GetFloatValue())
+		case priority.TypeInt:
+			got = convert.FloatToString(float64(v.GetIntValue()))
+		case priority.TypeBool:
+			got = convert.BoolToString(v.GetBoolValue())
+		case priority.TypeString:
+			got = v.GetStringValue()
+		case priority.TypeJSON:
+			got = v.GetJsonValue()
+		}
+		if got != test.expected || v.GetDataType() != string(test.to) {
+			t.Errorf("%v to %s: expected %s got %s", test.value, test.to, test.expected, got)
+		}
+	}
+	if _, _, err := c.Coerce(&runtime.PortValue{DataType: priority.TypeString, StringValue: ptr("abc")}, priority.TypeFloat); err == nil {
+		t.Fatal("expected a string that is not a number to fail")
+	}
+	if v, _, err := c.Coerce(&runtime.PortValue{DataType: priority.TypeBool, IsNil: true}, priority.TypeFloat); err != nil || !v.GetIsNil() {
+		t.Fatalf("expected a nil value to stay nil: %v %v", v, err)
+	}
+}
+
+func TestConnectionCoercion(t *testing.T) {
+	b := newTestBus()
+	a, c := newBaseAdd("a", b), newBaseAdd("c", b)
+	_ = a.NewOutputPort(NewPortBool("bool"))
+	_ = a.NewOutputPort(NewPortString("name"))
+	_ = a.NewOutputPort(NewPortAny("json"))
+	r := &RuntimeImpl{coercions: NewCoercions(false)}
+	r.AddObjects([]Object{a, c})
+
+	if err := a.NewOutputConnection("bool", "c", "in"); err != nil {
+		t.Fatal(err)
+	}
+	if connections := c.GetConnections(); len(connections) != 1 || connections[0].GetConversion() != "bool-to-float" {
+		t.Fatalf("expected the conversion on the connection: %v", connections)
+	}
+	_ = a.SetOutput("bool", true)
+	if v := c.GetPortValue("in"); v.GetFloatValue() != 1 || v.GetDataType() != priority.TypeFloat {
+		t.Fatalf("expected the bool to be converted: %v", v)
+	}
+
+	// a value that can not be converted is dropped and set as the input error
+	if err := a.NewOutputConnection("name", "c", "in"); err != nil {
+		t.Fatal(err)
+	}
+	_ = a.SetOutput("name", "abc")
+	if _, ok := c.GetValidation("in"); !ok || c.GetInput("in").GetValueFloat() != 1 {
+		t.Fatal("expected the input error and the last value")
+	}
+
+	r.coercions.SetStrict(true)
+	r.coercions.Deny(priority.TypeAny, priority.TypeFloat)
+	if err := a.NewOutputConnection("json", "c", "in"); err == nil {
+		t.Fatal("expected a strict runtime to reject the connection")
+	}
+}
+
+func TestDeployCoercion(t *testing.T) {
+	b := newTestBus()
+	r := &RuntimeImpl{coercions: NewCoercions(true)}
+	r.coercions.Deny(priority.TypeFloat, priority.TypeBool)
+	r.RegisterObject("add", func(config *runtime.ObjectConfig) (Object, error) {
+		o := newBaseAdd(config.GetMeta().GetObjectUUID(), b)
+		_ = o.NewInputPort(NewPortBool("enable"))
+		_ = o.NewOutputPort(NewPortBool("bool"))
+		return o, nil
+	})
+	var subscriber *runtime.Connection
+	deploy := func(sourcePort, targetPort string) *DeployResponse {
+		var publisher *runtime.Connection
+		publisher, subscriber = NewConnection("a", sourcePort, "c", targetPort)
+		a := deployConfig("add", "a", "", "")
+		a.Connections = []*runtime.Connection{publisher}
+		c := deployConfig("add", "c", "", "")
+		c.Connections = []*runtime.Connection{subscriber}
+		return r.Deploy(&Deploy{New: []*runtime.ObjectConfig{a, c}})
+	}
+	if resp := deploy("out", "enable"); resp.Ok || r.GetByUUID("a") != nil {
+		t.Fatal("expected a float to bool connection to fail the deploy")
+	}
+	if resp := deploy("bool", "in"); !resp.Ok {
+		t.Fatalf("unexpected response: %+v %+v", resp.Results[0], resp.Results[1])
+	}
+	if connections := r.GetByUUID("c").GetConnections(); len(connections) != 1 || connections[0].GetConversion() != "bool-to-float" {
+		t.Fatalf("expected the conversion on the connection: %v", connections)
+	}
+	if subscriber.GetConversion() != "" {
+		t.Fatal("expected the deploy body to be left as it was")
+	}
+	_ = r.GetByUUID("a").SetOutput("bool", true)
+	if v := r.GetByUUID("c").GetPortValue("in"); v.GetFloatValue() != 1 {
+		t.Fatalf("expected the input to be updated: %v", v)
+	}
+}
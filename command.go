@@ -385,6 +385,10 @@ func (p *ParsedCommand) GetID() string {
 	return p.ID
 }
 
+func (p *ParsedCommand) GetValue() string {
+	return p.Value
+}
+
 func (p *ParsedCommand) GetReturnAs() string {
 	return p.ReturnAs
 }
@@ -529,6 +533,8 @@ func (c *ExtendedCommand) ParseCommandsArgs(cmd *ExtendedCommand) (*ParsedComman
 		return args, nil
 	case commandCommand:
 		return args, nil
+	case commandOverrides:
+		return args, nil
 	case commandObjects, commandObject:
 		return args, nil
 	case commandInputs, commandOutputs, commandInput, commandOutput:
@@ -0,0 +1,158 @@
+package rxlib
+
+import (
+	"fmt"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
+)
+
+// Constraint is the Info permission or requirement that refused a change
+type Constraint string
+
+const (
+	ConstraintReadOnly             Constraint = "readOnly"
+	ConstraintCanBeCreated         Constraint = "canBeCreated"
+	ConstraintCanBeUpdated         Constraint = "canBeUpdated"
+	ConstraintCanBeDeleted         Constraint = "canBeDeleted"
+	ConstraintMaxOne               Constraint = "maxOne"
+	ConstraintMustLiveParent       Constraint = "mustLiveParent"
+	ConstraintMustLiveInObjectType Constraint = "mustLiveInObjectType"
+)
+
+// ConstraintError is a create, update or delete refused by the Info permissions or requirements of the object
+type ConstraintError struct {
+	ObjectUUID string
+	ObjectID   string
+	Constraint Constraint
+	Message    string
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("object: %s id: %s %s: %s", e.ObjectUUID, e.ObjectID, e.Constraint, e.Message)
+}
+
+func constraintError(object Object, constraint Constraint, message string, args ...any) *ConstraintError {
+	return &ConstraintError{
+		ObjectUUID: object.GetUUID(),
+		ObjectID:   object.GetID(),
+		Constraint: constraint,
+		Message:    fmt.Sprintf(message, args...),
+	}
+}
+
+// permitted returns true if the object has no permissions set, so it is not restricted, or it has all the permissions or the permission
+func permitted(permissions *runtime.Permissions, permission bool) bool {
+	return permissions == nil || permissions.GetAllPermissions() || permission
+}
+
+// checkCreate returns an error if the object can't be created, or if it is MaxOne and another object with the same id is in the objects
+func checkCreate(object Object, objects []Object) error {
+	info := object.GetInfo()
+	if permissions := info.GetPermissions(); !permitted(permissions, permissions.GetCanBeCreated()) {
+		return constraintError(object, ConstraintCanBeCreated, "the object can not be created")
+	}
+	if info.GetRequirements().GetMaxOne() {
+		for _, o := range objects {
+			if o != object && o.GetID() == object.GetID() {
+				return constraintError(object, ConstraintMaxOne, "only one object is allowed, there is already object: %s", o.GetUUID())
+			}
+		}
+	}
+	return nil
+}
+
+// checkUpdate returns an error if the object is read only or can't be updated, and the changes are not empty
+func checkUpdate(object Object, changes []*FieldChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	permissions := object.GetInfo().GetPermissions()
+	if permissions.GetReadOnly() {
+		return constraintError(object, ConstraintReadOnly, "the object is read only and can not be updated")
+	}
+	if !permitted(permissions, permissions.GetCanBeUpdated()) {
+		return constraintError(object, ConstraintCanBeUpdated, "the object can not be updated")
+	}
+	return nil
+}
+
+// checkDelete returns an error if the object is read only or can't be deleted
+func checkDelete(object Object) error {
+	permissions := object.GetInfo().GetPermissions()
+	if permissions.GetReadOnly() {
+		return constraintError(object, ConstraintReadOnly, "the object is read only and can not be deleted")
+	}
+	if !permitted(permissions, permissions.GetCanBeDeleted()) {
+		return constraintError(object, ConstraintCanBeDeleted, "the object can not be deleted")
+	}
+	return nil
+}
+
+// checkDeleteReferences calls fail with the uuid of a deleted object that is the parent of, or is connected to, an object that is
+// not deleted; the objects in skip are not checked, eg the updated objects of a deploy are checked with their new config
+func checkDeleteReferences(objects []Object, deleted, skip map[string]bool, fail func(uuid string, err error)) {
+	for _, object := range objects {
+		if deleted[object.GetUUID()] || skip[object.GetUUID()] {
+			continue
+		}
+		if parent := object.GetParentUUID(); deleted[parent] {
+			fail(parent, fmt.Errorf("object has a child object with uuid: %s that is not deleted", object.GetUUID()))
+		}
+		for _, connection := range object.GetConnections() {
+			for _, uuid := range []string{connection.GetSourceUUID(), connection.GetTargetUUID()} {
+				if deleted[uuid] {
+					fail(uuid, fmt.Errorf("object has connection: %s on object with uuid: %s that is not deleted or updated", connection.GetConnectionUUID(), object.GetUUID()))
+				}
+			}
+		}
+	}
+}
+
+// checkPlacement returns an error if the object must live under a parent and the parent is nil or the wrong type. MustLiveParent
+// needs a parent, of the WorkingGroupParent object id if set; MustLiveInObjectType needs a parent of the same object type
+func checkPlacement(object, parent Object) error {
+	info := object.GetInfo()
+	requirements := info.GetRequirements()
+	if requirements.GetMustLiveParent() {
+		if parent == nil {
+			return constraintError(object, ConstraintMustLiveParent, "the object must live under a parent object")
+		}
+		if id := info.GetWorkingGroupParent(); id != "" && parent.GetID() != id {
+			return constraintError(object, ConstraintMustLiveParent, "the object must live under a parent with object id: %s not: %s", id, parent.GetID())
+		}
+	}
+	if requirements.GetMustLiveInObjectType() {
+		if parent == nil {
+			return constraintError(object, ConstraintMustLiveInObjectType, "the object must live under a parent with object type: %s", info.GetObjectType())
+		}
+		if objectType := parent.GetInfo().GetObjectType(); objectType != info.GetObjectType() {
+			return constraintError(object, ConstraintMustLiveInObjectType, "the object must live under a parent with object type: %s not: %s", info.GetObjectType(), objectType)
+		}
+	}
+	return nil
+}
+
+// checkAdd runs the Deploy create and placement checks on the added objects that are not already in the runtime, against the objects
+// after the add; the caller must hold the mutex
+func (inst *RuntimeImpl) checkAdd(added, objects []Object) error {
+	objects = slices.DeleteFunc(slices.Clone(objects), func(o Object) bool { return o == nil })
+	byUUID := make(map[string]Object, len(objects))
+	for _, object := range objects {
+		byUUID[object.GetUUID()] = object
+	}
+	for _, object := range added {
+		if object == nil {
+			continue
+		}
+		if _, ok := inst.index.byUUID[object.GetUUID()]; ok {
+			continue
+		}
+		if err := checkCreate(object, objects); err != nil {
+			return err
+		}
+		if err := checkPlacement(object, byUUID[object.GetParentUUID()]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
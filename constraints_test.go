@@ -0,0 +1,134 @@
+package rxlib
+
+import (
+	"errors"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"strings"
+	"testing"
+)
+
+func newConstraintsRuntime() *RuntimeImpl {
+	r, _ := newDeployRuntime()
+	manager := &runtime.Info{
+		ObjectID:     "rubix-manager",
+		Permissions:  &runtime.Permissions{ReadOnly: true, CanBeCreated: true},
+		Requirements: &runtime.Requirements{MaxOne: true},
+	}
+	point := &runtime.Info{
+		ObjectID:           "point",
+		ObjectType:         string(Driver),
+		WorkingGroupParent: "device",
+		Requirements:       &runtime.Requirements{MustLiveParent: true, MustLiveInObjectType: true},
+	}
+	r.GetByUUID("device").(*deployObject).info = &runtime.Info{ObjectID: "device", ObjectType: string(Driver), Permissions: &runtime.Permissions{CanBeUpdated: true}}
+	r.AddObject(&deployObject{testObject: testObject{uuid: "manager", id: "rubix-manager", name: "manager", info: manager}, settings: "a"})
+	for id, info := range map[string]*runtime.Info{"rubix-manager": manager, "point": point} {
+		info := info
+		r.RegisterObject(id, func(config *runtime.ObjectConfig) (Object, error) {
+			return &deployObject{testObject: testObject{id: config.GetId(), info: info}}, nil
+		})
+	}
+	return r
+}
+
+func deployError(t *testing.T, resp *DeployResponse, objectUUID string, constraint Constraint) {
+	t.Helper()
+	if resp.Ok {
+		t.Fatalf("expected the deploy to fail with %s", constraint)
+	}
+	for _, result := range resp.Results {
+		if result.ObjectUUID == objectUUID {
+			if !strings.Contains(result.Error, string(constraint)) {
+				t.Fatalf("expected %s, got: %s", constraint, result.Error)
+			}
+			return
+		}
+	}
+	t.Fatalf("no result for object: %s", objectUUID)
+}
+
+func TestConstraints(t *testing.T) {
+	r := newConstraintsRuntime()
+
+	// read only
+	deployError(t, r.Deploy(&Deploy{Deleted: []string{"manager"}}), "manager", ConstraintReadOnly)
+	deployError(t, r.Deploy(&Deploy{Updated: []*runtime.ObjectConfig{deployConfig("rubix-manager", "manager", "", "b")}}), "manager", ConstraintReadOnly)
+	if resp := r.Deploy(&Deploy{Updated: []*runtime.ObjectConfig{deployConfig("rubix-manager", "manager", "", "a")}}); !resp.Ok {
+		t.Fatalf("expected an update with no changes to be allowed: %+v", resp.Results[0])
+	}
+	var constraintErr *ConstraintError
+	if err := r.DeleteByUUID("manager"); !errors.As(err, &constraintErr) || constraintErr.Constraint != ConstraintReadOnly {
+		t.Fatalf("expected a read only error, got: %v", err)
+	}
+	if r.GetByUUID("manager") == nil {
+		t.Fatal("expected the manager to not be deleted")
+	}
+
+	// the permissions are set, but can't be deleted
+	if err := r.DeleteByUUID("device"); !errors.As(err, &constraintErr) || constraintErr.Constraint != ConstraintCanBeDeleted {
+		t.Fatalf("expected a can be deleted error, got: %v", err)
+	}
+	// the same child and connection checks as a deploy
+	if err := r.DeleteByUUID("network"); err == nil || !strings.Contains(err.Error(), "child object") || r.GetByUUID("network") == nil {
+		t.Fatalf("expected the network with a child to not be deleted, got: %v", err)
+	}
+	if err := r.DeleteByUUID("nope"); err == nil || len(r.Get()) != 4 {
+		t.Fatalf("expected a not found error, got: %v", err)
+	}
+
+	// max one
+	resp := r.Deploy(&Deploy{New: []*runtime.ObjectConfig{deployConfig("rubix-manager", "manager-2", "", "")}})
+	deployError(t, resp, "manager-2", ConstraintMaxOne)
+	if r.GetByUUID("manager-2") != nil {
+		t.Fatal("expected the second manager to not be added")
+	}
+
+	// placement
+	deployError(t, r.Deploy(&Deploy{New: []*runtime.ObjectConfig{deployConfig("point", "point", "", "")}}), "point", ConstraintMustLiveParent)
+	deployError(t, r.Deploy(&Deploy{New: []*runtime.ObjectConfig{deployConfig("point", "point", "network", "")}}), "point", ConstraintMustLiveParent)
+	if resp := r.Deploy(&Deploy{New: []*runtime.ObjectConfig{deployConfig("point", "point", "device", "")}}); !resp.Ok {
+		t.Fatalf("expected the point to be added under the device: %+v", resp.Results[0])
+	}
+	r.GetByUUID("device").(*deployObject).info.ObjectType = string(Logic)
+	deployError(t, r.Deploy(&Deploy{New: []*runtime.ObjectConfig{deployConfig("point", "point-2", "device", "")}}), "point-2", ConstraintMustLiveInObjectType)
+}
+
+func TestAddObjectConstraints(t *testing.T) {
+	r := newConstraintsRuntime()
+	manager := r.GetByUUID("manager").(*deployObject)
+	var constraintErr *ConstraintError
+
+	// max one
+	second := &deployObject{testObject: testObject{uuid: "manager-2", id: "rubix-manager", info: manager.info}}
+	if err := r.AddObject(second); !errors.As(err, &constraintErr) || constraintErr.Constraint != ConstraintMaxOne {
+		t.Fatalf("expected a max one error, got: %v", err)
+	}
+	if r.GetByUUID("manager-2") != nil {
+		t.Fatal("expected the second manager to not be added")
+	}
+	// replacing the existing object is not a create
+	if err := r.AddObject(&deployObject{testObject: testObject{uuid: "manager", id: "rubix-manager", info: manager.info}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// placement, and nothing is changed when one of the objects is refused
+	info := &runtime.Info{ObjectID: "point", ObjectType: string(Driver), Requirements: &runtime.Requirements{MustLiveParent: true}}
+	point := &deployObject{testObject: testObject{uuid: "point", id: "point", info: info}}
+	count := len(r.Get())
+	if err := r.AddObjects(append(r.Get(), point)); !errors.As(err, &constraintErr) || constraintErr.Constraint != ConstraintMustLiveParent {
+		t.Fatalf("expected a must live parent error, got: %v", err)
+	}
+	if len(r.Get()) != count {
+		t.Fatal("expected the objects to not be changed")
+	}
+	point.parent = "device"
+	if err := r.AddObjects(append(r.Get(), point)); err != nil || r.GetByUUID("point") == nil {
+		t.Fatalf("expected the point to be added under the device: %v", err)
+	}
+
+	// can be created
+	info = &runtime.Info{ObjectID: "locked", Permissions: &runtime.Permissions{CanBeUpdated: true}}
+	if err := r.AddObject(&deployObject{testObject: testObject{uuid: "locked", id: "locked", info: info}}); !errors.As(err, &constraintErr) || constraintErr.Constraint != ConstraintCanBeCreated {
+		t.Fatalf("expected a can be created error, got: %v", err)
+	}
+}
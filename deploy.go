@@ -75,10 +75,14 @@ func (inst *RuntimeImpl) UUID() string {
 }
 
 type DeployResponse struct {
-	Message string `json:"message"`
+	Message    string          `json:"message"`
+	Ok         bool            `json:"ok"`
+	RolledBack bool            `json:"rolledBack,omitempty"`
+	Results    []*DeployResult `json:"results,omitempty"`
 }
 
-func (inst *RuntimeImpl) Deploy(body *Deploy) *DeployResponse {
+// DeployRemote posts the deploy to the runtime api of another instance; eg DeployRemote("http://localhost:1770/api/runtime", body)
+func (inst *RuntimeImpl) DeployRemote(url string, body *Deploy) *DeployResponse {
 	var invalidBody bool
 	var message string
 	if body == nil {
@@ -99,13 +103,12 @@ func (inst *RuntimeImpl) Deploy(body *Deploy) *DeployResponse {
 		}
 	}
 
-	var existingCount = len(inst.Get())
 	opts := &restc.Options{
 		Headers: nil,
 		Body:    body,
 	}
 
-	resp := inst.rest.Execute("POST", "http://localhost:1770/api/runtime", opts)
+	resp := inst.rest.Execute("POST", url, opts)
 	var ok bool
 	if resp.Code() >= 200 && resp.Code() < 300 {
 		ok = true
@@ -119,11 +122,9 @@ func (inst *RuntimeImpl) Deploy(body *Deploy) *DeployResponse {
 			Message: message,
 		}
 	}
-
-	var newCount = len(inst.Get())
-	message = fmt.Sprintf("existingCount: %d current objects count: %d", existingCount, newCount)
 	return &DeployResponse{
-		Message: message,
+		Message: fmt.Sprintf("deployed to: %s", url),
+		Ok:      true,
 	}
 }
 
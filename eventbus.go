@@ -1,7 +1,14 @@
 package rxlib
 
-import "time"
+import (
+	"github.com/NubeIO/rxlib/libs/bus"
+	"time"
+)
 
+// EventbusOpts when a payload is older than the TTL it is passed to the callback with an err wrapping payload.ErrExpired,
+// or dropped if DropExpired is set
 type EventbusOpts struct {
-	TTL time.Duration `json:"ttl"` // time to live timeout in seconds
+	TTL         time.Duration `json:"ttl"`              // time to live timeout in seconds
+	DropExpired bool          `json:"dropExpired"`      // drop the expired payloads instead of passing them with the err
+	Replay      *bus.Replay   `json:"replay,omitempty"` // retained payloads to deliver on subscribe, eg: the latest value of an output
 }
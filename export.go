@@ -0,0 +1,427 @@
+package rxlib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/helpers"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// FlowKind is the kind of the flow export document
+	FlowKind = "rxlib/flow"
+
+	// FlowVersion is the version of the flow export document written by this version of rxlib, it is bumped when runtime.ObjectConfig changes in a way old exports can't be read
+	FlowVersion = 1
+)
+
+type FlowFormat string
+
+const (
+	FlowJSON FlowFormat = "json"
+	FlowYAML FlowFormat = "yaml"
+)
+
+// FlowDocument is an exported flow, or a subtree of a flow
+type FlowDocument struct {
+	Kind     string        `json:"kind"`
+	Version  int           `json:"version"`
+	Exported time.Time     `json:"exported"`
+	RootUUID string        `json:"rootUUID,omitempty"` // the root object of the subtree, empty for the whole flow
+	Objects  []*FlowObject `json:"objects"`
+}
+
+type FlowObject struct {
+	Config     *runtime.ObjectConfig   `json:"config"`
+	Extensions []*runtime.ObjectConfig `json:"extensions,omitempty"`
+}
+
+// FlowMigration upgrades a raw flow document from its version to the next version
+type FlowMigration func(doc map[string]any) error
+
+var flowMigrations = struct {
+	sync.RWMutex
+	byVersion map[int]FlowMigration
+}{byVersion: map[int]FlowMigration{0: migrateFlowV0}}
+
+// RegisterFlowMigration adds the migration that upgrades a flow document from the version to version+1
+func RegisterFlowMigration(fromVersion int, migration FlowMigration) {
+	flowMigrations.Lock()
+	defer flowMigrations.Unlock()
+	flowMigrations.byVersion[fromVersion] = migration
+}
+
+// migrateFlowV0 version 0 is the JSON array of GetObjectsConfig(), used before the flow document had a version
+func migrateFlowV0(doc map[string]any) error {
+	objects, _ := doc["objects"].([]any)
+	out := make([]any, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, map[string]any{"config": object})
+	}
+	doc["kind"] = FlowKind
+	doc["objects"] = out
+	return nil
+}
+
+// ExportFlow exports the object and its children, or the whole flow if the rootUUID is empty. The stats and port values are left out
+func (inst *RuntimeImpl) ExportFlow(rootUUID string) (*FlowDocument, error) {
+	var objects []Object
+	if rootUUID == "" {
+		objects = inst.Get()
+	} else {
+		root := inst.GetByUUID(rootUUID)
+		if root == nil {
+			return nil, fmt.Errorf("not found object with uuid: %s", rootUUID)
+		}
+		objects = append(objects, root)
+		for i := 0; i < len(objects); i++ {
+			objects = append(objects, inst.GetChildObjects(objects[i].GetUUID())...)
+		}
+	}
+	doc := &FlowDocument{
+		Kind:     FlowKind,
+		Version:  FlowVersion,
+		Exported: time.Now().UTC(),
+		RootUUID: rootUUID,
+	}
+	for i, config := range inst.SerializeObjects(false, objects) {
+		o := &FlowObject{Config: exportConfig(config)}
+		for _, extension := range inst.SerializeObjects(false, objects[i].GetExtensions()) {
+			o.Extensions = append(o.Extensions, exportConfig(extension))
+		}
+		doc.Objects = append(doc.Objects, o)
+	}
+	return doc, nil
+}
+
+func exportConfig(config *runtime.ObjectConfig) *runtime.ObjectConfig {
+	c, _ := proto.Clone(config).(*runtime.ObjectConfig)
+	c.Stats = nil
+	c.PortValues = nil
+	return c
+}
+
+// Marshal encodes the document as JSON or YAML, the object configs use the protobuf JSON names
+func (d *FlowDocument) Marshal(format FlowFormat) ([]byte, error) {
+	doc, err := d.toMap()
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case FlowJSON, "":
+		return json.MarshalIndent(doc, "", "  ")
+	case FlowYAML:
+		return yaml.Marshal(doc)
+	}
+	return nil, fmt.Errorf("unknown flow format: %s", format)
+}
+
+func (d *FlowDocument) toMap() (map[string]any, error) {
+	objects := make([]any, 0, len(d.Objects))
+	for _, o := range d.Objects {
+		config, err := configToMap(o.Config)
+		if err != nil {
+			return nil, err
+		}
+		object := map[string]any{"config": config}
+		if len(o.Extensions) > 0 {
+			extensions := make([]any, 0, len(o.Extensions))
+			for _, extension := range o.Extensions {
+				e, err := configToMap(extension)
+				if err != nil {
+					return nil, err
+				}
+				extensions = append(extensions, e)
+			}
+			object["extensions"] = extensions
+		}
+		objects = append(objects, object)
+	}
+	doc := map[string]any{
+		"kind":     d.Kind,
+		"version":  d.Version,
+		"exported": d.Exported.Format(time.RFC3339),
+		"objects":  objects,
+	}
+	if d.RootUUID != "" {
+		doc["rootUUID"] = d.RootUUID
+	}
+	return doc, nil
+}
+
+func configToMap(config *runtime.ObjectConfig) (map[string]any, error) {
+	b, err := protojson.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	err = json.Unmarshal(b, &out)
+	return out, err
+}
+
+// ReadFlow decodes a JSON or YAML flow document and runs the migrations up to the FlowVersion
+func ReadFlow(data []byte) (*FlowDocument, error) {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("read flow err: %v", err)
+	}
+	var doc map[string]any
+	switch r := raw.(type) {
+	case []any:
+		doc = map[string]any{"version": 0, "objects": r}
+	case map[string]any:
+		doc = r
+	default:
+		return nil, errors.New("read flow err: the document must be an object or an array of objects")
+	}
+	if err := migrateFlow(doc, FlowVersion); err != nil {
+		return nil, err
+	}
+	if kind, _ := doc["kind"].(string); kind != FlowKind {
+		return nil, fmt.Errorf("read flow err: unknown kind: %v", doc["kind"])
+	}
+	return flowFromMap(doc)
+}
+
+// migrateFlow runs the migrations from the version of the document to the version
+func migrateFlow(doc map[string]any, to int) error {
+	version, err := flowDocVersion(doc)
+	if err != nil {
+		return err
+	}
+	if version > to {
+		return fmt.Errorf("flow version: %d is newer than the supported version: %d", version, to)
+	}
+	flowMigrations.RLock()
+	defer flowMigrations.RUnlock()
+	for ; version < to; version++ {
+		migration, ok := flowMigrations.byVersion[version]
+		if !ok {
+			return fmt.Errorf("no flow migration from version: %d", version)
+		}
+		if err := migration(doc); err != nil {
+			return fmt.Errorf("flow migration from version: %d err: %v", version, err)
+		}
+		doc["version"] = version + 1
+	}
+	return nil
+}
+
+func flowDocVersion(doc map[string]any) (int, error) {
+	switch v := doc["version"].(type) {
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	case nil:
+		return 0, nil
+	}
+	return 0, fmt.Errorf("flow version: %v is not a number", doc["version"])
+}
+
+func flowFromMap(doc map[string]any) (*FlowDocument, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	var raw struct {
+		Kind     string `json:"kind"`
+		Version  int    `json:"version"`
+		Exported string `json:"exported"`
+		RootUUID string `json:"rootUUID"`
+		Objects  []struct {
+			Config     json.RawMessage   `json:"config"`
+			Extensions []json.RawMessage `json:"extensions"`
+		} `json:"objects"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("read flow err: %v", err)
+	}
+	out := &FlowDocument{Kind: raw.Kind, Version: raw.Version, RootUUID: raw.RootUUID}
+	if raw.Exported != "" {
+		if out.Exported, err = time.Parse(time.RFC3339, raw.Exported); err != nil {
+			return nil, fmt.Errorf("read flow exported time err: %v", err)
+		}
+	}
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	for i, o := range raw.Objects {
+		object := &FlowObject{Config: &runtime.ObjectConfig{}}
+		if err := unmarshal.Unmarshal(o.Config, object.Config); err != nil {
+			return nil, fmt.Errorf("read flow object: %d err: %v", i, err)
+		}
+		for _, e := range o.Extensions {
+			extension := &runtime.ObjectConfig{}
+			if err := unmarshal.Unmarshal(e, extension); err != nil {
+				return nil, fmt.Errorf("read flow object: %d extension err: %v", i, err)
+			}
+			object.Extensions = append(object.Extensions, extension)
+		}
+		out.Objects = append(out.Objects, object)
+	}
+	return out, nil
+}
+
+type FlowImportOpts struct {
+	NewUUIDs   bool   // give the objects and connections new uuids, used to import a copy of a flow that is already in the runtime
+	ParentUUID string // the parent of the objects that have no parent in the document; eg import a device into another network
+}
+
+// ImportFlow deploys the objects of the document as new objects, and then adds their extensions; the import is rolled back if an extension can't be added. The connections to objects that are not in the document or the runtime are left out
+func (inst *RuntimeImpl) ImportFlow(doc *FlowDocument, opts *FlowImportOpts) *DeployResponse {
+	if doc == nil {
+		return &DeployResponse{Message: "Import failed. document is nil"}
+	}
+	if opts == nil {
+		opts = &FlowImportOpts{}
+	}
+	uuids := make(map[string]string) // old -> new
+	for _, o := range doc.Objects {
+		uuid := o.Config.GetMeta().GetObjectUUID()
+		uuids[uuid] = uuid
+		if opts.NewUUIDs {
+			uuids[uuid] = helpers.UUID()
+		}
+	}
+	mapUUID := func(uuid string) (string, bool) {
+		if n, ok := uuids[uuid]; ok {
+			return n, true
+		}
+		return uuid, inst.GetByUUID(uuid) != nil
+	}
+	connections := make(map[string]string)
+	mapConnection := func(uuid string) string {
+		if !opts.NewUUIDs || uuid == "" {
+			return uuid
+		}
+		if _, ok := connections[uuid]; !ok {
+			connections[uuid] = helpers.UUID()
+		}
+		return connections[uuid]
+	}
+
+	body := &Deploy{}
+	var owners []string // the objects with extensions, in the document order
+	extensions := make(map[string][]*runtime.ObjectConfig)
+	for _, o := range doc.Objects {
+		config, _ := proto.Clone(o.Config).(*runtime.ObjectConfig)
+		if config.Meta == nil {
+			config.Meta = &runtime.Meta{}
+		}
+		meta := config.Meta
+		meta.ObjectUUID = uuids[meta.GetObjectUUID()]
+		if parent, ok := uuids[meta.GetParentUUID()]; ok && meta.GetParentUUID() != "" {
+			meta.ParentUUID = parent
+		} else if opts.ParentUUID != "" {
+			meta.ParentUUID = opts.ParentUUID
+		}
+		var kept []*runtime.Connection
+		for _, connection := range config.GetConnections() {
+			source, sourceOk := mapUUID(connection.GetSourceUUID())
+			target, targetOk := mapUUID(connection.GetTargetUUID())
+			if !sourceOk || !targetOk {
+				continue
+			}
+			connection.SourceUUID, connection.TargetUUID = source, target
+			connection.ConnectionUUID = mapConnection(connection.GetConnectionUUID())
+			connection.TargetConnectionUUID = mapConnection(connection.GetTargetConnectionUUID())
+			kept = append(kept, connection)
+		}
+		config.Connections = kept
+		built := inst.ObjectBuilder(&Builder{
+			UUID:         meta.GetObjectUUID(),
+			ObjectID:     config.GetId(),
+			ParentUUID:   meta.GetParentUUID(),
+			ObjectConfig: config,
+		}).ToObject()
+		body.New = append(body.New, built)
+		if len(o.Extensions) > 0 {
+			owners = append(owners, meta.GetObjectUUID())
+			extensions[meta.GetObjectUUID()] = o.Extensions
+		}
+	}
+	if len(body.New) == 0 {
+		return &DeployResponse{Message: "Import failed. nothing to import"}
+	}
+
+	// the extensions are built before the deploy, so an extension that can't be built fails the import without changes
+	type importedExtension struct {
+		owner     string
+		extension Object
+		result    *DeployResult
+	}
+	var imported []*importedExtension
+	var results []*DeployResult
+	var buildErr error
+	for _, uuid := range owners {
+		for _, config := range extensions[uuid] {
+			config, _ = proto.Clone(config).(*runtime.ObjectConfig)
+			if config.Meta == nil {
+				config.Meta = &runtime.Meta{}
+			}
+			if opts.NewUUIDs {
+				config.Meta.ObjectUUID = helpers.UUID()
+			}
+			config.Meta.ParentUUID = uuid
+			r := &DeployResult{ObjectUUID: config.GetMeta().GetObjectUUID(), ObjectID: config.GetId(), Action: DeployNew, Ok: true}
+			results = append(results, r)
+			extension, err := inst.buildExtension(config)
+			if err != nil {
+				r.fail(fmt.Errorf("extension of object: %s err: %v", uuid, err))
+				buildErr = err
+				continue
+			}
+			imported = append(imported, &importedExtension{owner: uuid, extension: extension, result: r})
+		}
+	}
+	if buildErr != nil {
+		return &DeployResponse{Message: fmt.Sprintf("Import failed. extension err: %v", buildErr), Results: results}
+	}
+
+	resp := inst.Deploy(body)
+	if !resp.Ok {
+		return resp
+	}
+	resp.Results = append(resp.Results, results...)
+	for _, e := range imported {
+		if err := inst.GetByUUID(e.owner).AddExtension(e.extension); err != nil {
+			e.result.fail(fmt.Errorf("extension of object: %s err: %v", e.owner, err))
+			inst.rollbackImport(body, resp, err)
+			return resp
+		}
+	}
+	return resp
+}
+
+// rollbackImport deletes the objects of an import when one of their extensions could not be added
+func (inst *RuntimeImpl) rollbackImport(body *Deploy, resp *DeployResponse, err error) {
+	var uuids []string
+	for _, config := range body.New {
+		uuids = append(uuids, config.GetMeta().GetObjectUUID())
+	}
+	if deleted := inst.Deploy(&Deploy{Deleted: uuids}); !deleted.Ok {
+		log.Printf("import rollback: %s", deleted.Message)
+	}
+	resp.Ok = false
+	resp.RolledBack = true
+	resp.Message = fmt.Sprintf("Import failed. rolled back err: %v", err)
+	for _, r := range resp.Results {
+		if r.Ok {
+			r.Ok = false
+			r.RolledBack = true
+		}
+	}
+}
+
+func (inst *RuntimeImpl) buildExtension(config *runtime.ObjectConfig) (Object, error) {
+	if err := validateObjectConfig(config); err != nil {
+		return nil, err
+	}
+	return inst.buildObject(config)
+}
@@ -0,0 +1,109 @@
+package rxlib
+
+import (
+	"errors"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestFlowExportImport(t *testing.T) {
+	r, built := newDeployRuntime()
+	r.RegisterObject("network", func(config *runtime.ObjectConfig) (Object, error) {
+		o := &deployObject{testObject: testObject{id: config.GetId()}}
+		built[config.GetMeta().GetObjectUUID()] = o
+		return o, nil
+	})
+	r.RegisterObject("history", func(config *runtime.ObjectConfig) (Object, error) {
+		return &deployObject{testObject: testObject{id: config.GetId()}}, nil
+	})
+	network := r.GetByUUID("network").(*deployObject)
+	network.extensions = []Object{&deployObject{testObject: testObject{uuid: "history", id: "history"}, settings: "cov"}}
+
+	doc, err := r.ExportFlow("network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Objects) != 2 || doc.Objects[1].Config.GetMeta().GetObjectUUID() != "device" || len(doc.Objects[0].Extensions) != 1 {
+		t.Fatalf("unexpected export: %+v", doc)
+	}
+	for _, format := range []FlowFormat{FlowJSON, FlowYAML} {
+		b, err := doc.Marshal(format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		read, err := ReadFlow(b)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if read.Version != FlowVersion || read.RootUUID != "network" || !read.Exported.Equal(doc.Exported.Truncate(1e9)) || len(read.Objects) != 2 {
+			t.Fatalf("%s: unexpected document: %+v", format, read)
+		}
+		for i := range doc.Objects {
+			if !proto.Equal(doc.Objects[i].Config, read.Objects[i].Config) {
+				t.Fatalf("%s: expected %v got %v", format, doc.Objects[i].Config, read.Objects[i].Config)
+			}
+		}
+	}
+
+	// a copy of the network with new uuids
+	resp := r.ImportFlow(doc, &FlowImportOpts{NewUUIDs: true})
+	if !resp.Ok || len(resp.Results) != 3 || len(r.Get()) != 5 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	networkCopy := r.GetByUUID(resp.Results[0].ObjectUUID).(*deployObject)
+	deviceCopy := r.GetByUUID(resp.Results[1].ObjectUUID).(*deployObject)
+	if networkCopy == network || deviceCopy.parent != networkCopy.uuid || deviceCopy.settings != "a" {
+		t.Fatal("expected the device copy to be under the network copy")
+	}
+	if len(networkCopy.extensions) != 1 || networkCopy.extensions[0].GetUUID() == "history" || networkCopy.extensions[0].(*deployObject).settings != "cov" {
+		t.Fatal("expected the extension to be copied")
+	}
+
+	// an extension that can't be added rolls back the import
+	r.RegisterObject("network", func(config *runtime.ObjectConfig) (Object, error) {
+		return &deployObject{testObject: testObject{id: config.GetId()}, extensionErr: errors.New("no extensions")}, nil
+	})
+	resp = r.ImportFlow(doc, &FlowImportOpts{NewUUIDs: true})
+	if resp.Ok || !resp.RolledBack || len(resp.Results) != 3 || !resp.Results[0].RolledBack || resp.Results[2].Error == "" || len(r.Get()) != 5 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestReadFlowMigrations(t *testing.T) {
+	// the exports from before the document had a version are the array of object configs
+	doc, err := ReadFlow([]byte(`[{"id": "add", "meta": {"objectUUID": "abc", "objectName": "add"}, "settings": {"value": "{}"}}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.Kind != FlowKind || doc.Version != FlowVersion || len(doc.Objects) != 1 || doc.Objects[0].Config.GetMeta().GetObjectUUID() != "abc" {
+		t.Fatalf("unexpected document: %+v", doc)
+	}
+
+	RegisterFlowMigration(FlowVersion, func(doc map[string]any) error {
+		for _, object := range doc["objects"].([]any) {
+			config := object.(map[string]any)["config"].(map[string]any)
+			config["id"] = "math-" + config["id"].(string)
+		}
+		return nil
+	})
+	defer func() {
+		flowMigrations.Lock()
+		delete(flowMigrations.byVersion, FlowVersion)
+		flowMigrations.Unlock()
+	}()
+	raw := map[string]any{"objects": []any{map[string]any{"id": "add"}}}
+	if err := migrateFlow(raw, FlowVersion+1); err != nil {
+		t.Fatal(err)
+	}
+	config := raw["objects"].([]any)[0].(map[string]any)["config"].(map[string]any)
+	if raw["version"] != FlowVersion+1 || config["id"] != "math-add" {
+		t.Fatalf("unexpected migrated document: %v", raw)
+	}
+	if err := migrateFlow(map[string]any{"version": FlowVersion + 2}, FlowVersion+1); err == nil {
+		t.Fatal("expected an err for a newer version")
+	}
+	if _, err := ReadFlow([]byte(`{"kind": "other", "version": 1}`)); err == nil {
+		t.Fatal("expected an err for an unknown kind")
+	}
+}
@@ -0,0 +1,479 @@
+package rxlib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FlowMode is how the flow executor decides when to call Process()
+type FlowMode string
+
+const (
+	FlowTick  FlowMode = "tick"  // all the objects are processed in order on each tick
+	FlowEvent FlowMode = "event" // an object is processed when it is triggered, and then everything downstream of it
+)
+
+const defaultFlowInterval = time.Second
+
+type FlowOpts struct {
+	Mode     FlowMode      // default is tick
+	Interval time.Duration // the tick interval; default 1 sec
+}
+
+// CycleError is returned when the connections of the objects loop back on themselves
+type CycleError struct {
+	UUIDs []string // the objects in the cycle, the first uuid is repeated at the end
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("flow has a cycle: %s", strings.Join(e.UUIDs, " -> "))
+}
+
+// FlowExecutor calls Process() on the objects in the order of their connections, so a publisher is processed before its subscribers
+type FlowExecutor interface {
+	// Build the graph from the object connections, once built it is built again when the runtime objects are added or removed
+	Build() error
+	// Order returns the object uuids in the order they are processed
+	Order() []string
+	// RunOnce processes all the objects in order
+	RunOnce() []error
+	// Trigger queues an object to be processed, with everything downstream of it; used in event mode when an input changes
+	Trigger(objectUUID string)
+	// Start builds the graph and starts the tick or event loop
+	Start() error
+	// Stop the loop, waiting for the current pass to finish
+	Stop()
+	// Stats returns the Process() timings of an object
+	Stats(objectUUID string) *runtime.ObjectStats
+}
+
+// StatsUpdater is an object that lets the flow executor write its Process() timings under the object lock, objects without it get them written to GetStats()
+type StatsUpdater interface {
+	UpdateStats(update func(stats *runtime.ObjectStats))
+}
+
+type flowStats struct {
+	count uint64
+	last  time.Duration
+	max   time.Duration
+	total time.Duration
+	err   string
+}
+
+type flowExecutor struct {
+	runtime    *RuntimeImpl
+	opts       *FlowOpts
+	mutex      sync.Mutex
+	built      bool
+	order      []string
+	index      map[string]int      // position in the order
+	downstream map[string][]string // subscribers of each object
+	runMutex   sync.Mutex          // one pass at a time
+	statsMutex sync.Mutex
+	stats      map[string]*flowStats
+	pending    map[string]struct{}
+	wake       chan struct{}
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+func newFlowExecutor(r *RuntimeImpl, opts *FlowOpts) *flowExecutor {
+	if opts == nil {
+		opts = &FlowOpts{}
+	}
+	if opts.Mode == "" {
+		opts.Mode = FlowTick
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = defaultFlowInterval
+	}
+	return &flowExecutor{
+		runtime: r,
+		opts:    opts,
+		stats:   make(map[string]*flowStats),
+		pending: make(map[string]struct{}),
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+func (inst *RuntimeImpl) Flow() FlowExecutor {
+	if inst.flow == nil {
+		return nil
+	}
+	return inst.flow
+}
+
+func (f *flowExecutor) Build() error {
+	order, downstream, err := flowGraph(f.runtime.Get())
+	if err != nil {
+		return err
+	}
+	index := make(map[string]int, len(order))
+	for i, uuid := range order {
+		index[uuid] = i
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.order = order
+	f.index = index
+	f.downstream = downstream
+	f.built = true
+	return nil
+}
+
+// flowGraph sorts the objects by their connections, it is used to check the objects of a deploy before they are swapped in
+func flowGraph(objects []Object) ([]string, map[string][]string, error) {
+	uuids := make([]string, 0, len(objects))
+	known := make(map[string]bool, len(objects))
+	for _, object := range objects {
+		uuids = append(uuids, object.GetUUID())
+		known[object.GetUUID()] = true
+	}
+	edges := make(map[string]map[string]struct{})
+	for _, object := range objects {
+		for _, connection := range object.GetConnections() {
+			source, target := connection.GetSourceUUID(), connection.GetTargetUUID()
+			if connection.GetDisable() || !known[source] || !known[target] {
+				continue
+			}
+			if edges[source] == nil {
+				edges[source] = make(map[string]struct{})
+			}
+			edges[source][target] = struct{}{}
+		}
+	}
+	return sortFlow(uuids, edges)
+}
+
+// sortFlow is a topological sort of the objects, the objects without a dependency keep the runtime order
+func sortFlow(uuids []string, edges map[string]map[string]struct{}) ([]string, map[string][]string, error) {
+	position := make(map[string]int, len(uuids))
+	for i, uuid := range uuids {
+		position[uuid] = i
+	}
+	downstream := make(map[string][]string, len(edges))
+	inDegree := make(map[string]int, len(uuids))
+	for source, targets := range edges {
+		for target := range targets {
+			downstream[source] = append(downstream[source], target)
+			inDegree[target]++
+		}
+		sort.Slice(downstream[source], func(i, j int) bool {
+			return position[downstream[source][i]] < position[downstream[source][j]]
+		})
+	}
+
+	var queue, order []string
+	for _, uuid := range uuids {
+		if inDegree[uuid] == 0 {
+			queue = append(queue, uuid)
+		}
+	}
+	for len(queue) > 0 {
+		uuid := queue[0]
+		queue = queue[1:]
+		order = append(order, uuid)
+		for _, target := range downstream[uuid] {
+			inDegree[target]--
+			if inDegree[target] == 0 {
+				queue = append(queue, target)
+			}
+		}
+	}
+	if len(order) < len(uuids) {
+		return nil, nil, &CycleError{UUIDs: findCycle(uuids, downstream, inDegree)}
+	}
+	return order, downstream, nil
+}
+
+// findCycle walks the objects left over from the sort, each has an upstream object in a cycle
+func findCycle(uuids []string, downstream map[string][]string, inDegree map[string]int) []string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var path []string
+	var walk func(uuid string) []string
+	walk = func(uuid string) []string {
+		state[uuid] = visiting
+		path = append(path, uuid)
+		for _, target := range downstream[uuid] {
+			if inDegree[target] == 0 {
+				continue
+			}
+			switch state[target] {
+			case visiting:
+				for i, p := range path {
+					if p == target {
+						return append(append([]string{}, path[i:]...), target)
+					}
+				}
+			case 0:
+				if cycle := walk(target); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[uuid] = visited
+		return nil
+	}
+	for _, uuid := range uuids {
+		if inDegree[uuid] > 0 && state[uuid] == 0 {
+			if cycle := walk(uuid); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// objectsChanged builds the graph again once it has been built, so the ticks don't run the objects that were removed and miss
+// the objects that were added; if the objects now loop back on themselves the next RunOnce returns the cycle
+func (f *flowExecutor) objectsChanged() {
+	f.mutex.Lock()
+	built := f.built
+	f.mutex.Unlock()
+	if !built {
+		return
+	}
+	if err := f.Build(); err != nil {
+		f.mutex.Lock()
+		f.built = false
+		f.mutex.Unlock()
+		log.Printf("flow: %v", err)
+	}
+}
+
+func (f *flowExecutor) Order() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string{}, f.order...)
+}
+
+func (f *flowExecutor) RunOnce() []error {
+	f.mutex.Lock()
+	built := f.built
+	f.mutex.Unlock()
+	if !built {
+		if err := f.Build(); err != nil {
+			return []error{err}
+		}
+	}
+	return f.process(f.Order())
+}
+
+func (f *flowExecutor) Trigger(objectUUID string) {
+	if f.opts.Mode != FlowEvent {
+		return
+	}
+	f.mutex.Lock()
+	f.pending[objectUUID] = struct{}{}
+	f.mutex.Unlock()
+	select {
+	case f.wake <- struct{}{}:
+	default:
+	}
+}
+
+// runPending processes the triggered objects and everything downstream of them, in order
+func (f *flowExecutor) runPending() []error {
+	f.mutex.Lock()
+	affected := make(map[string]struct{})
+	queue := make([]string, 0, len(f.pending))
+	for uuid := range f.pending {
+		queue = append(queue, uuid)
+	}
+	f.pending = make(map[string]struct{})
+	for len(queue) > 0 {
+		uuid := queue[0]
+		queue = queue[1:]
+		if _, ok := affected[uuid]; ok {
+			continue
+		}
+		if _, ok := f.index[uuid]; !ok {
+			continue
+		}
+		affected[uuid] = struct{}{}
+		queue = append(queue, f.downstream[uuid]...)
+	}
+	uuids := make([]string, 0, len(affected))
+	for uuid := range affected {
+		uuids = append(uuids, uuid)
+	}
+	sort.Slice(uuids, func(i, j int) bool {
+		return f.index[uuids[i]] < f.index[uuids[j]]
+	})
+	f.mutex.Unlock()
+	return f.process(uuids)
+}
+
+func (f *flowExecutor) process(uuids []string) []error {
+	f.runMutex.Lock()
+	defer f.runMutex.Unlock()
+	var errs []error
+	for _, uuid := range uuids {
+		object := f.runtime.GetByUUID(uuid)
+		if object == nil {
+			continue
+		}
+		if err := f.processObject(object); err != nil {
+			errs = append(errs, fmt.Errorf("process object: %s err: %v", uuid, err))
+		}
+		f.runtime.portValuesChanged(object)
+	}
+	return errs
+}
+
+func (f *flowExecutor) processObject(object Object) (err error) {
+	object.SetStatus(StatsProcessing)
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+		f.record(object, time.Since(start), err)
+		switch {
+		case err != nil:
+			object.SetStatus(StatsError)
+		case objectStatus(object) == StatsProcessing:
+			// an object that set its own status in Process(), eg an error, keeps it
+			object.SetStatus(StatsIdle)
+		}
+		f.runtime.NotifyStatus(object.GetUUID(), objectStatus(object))
+	}()
+	return f.call(object)
+}
+
+func objectStatus(object Object) ObjectStatus {
+	if stats := object.GetStats(); stats != nil {
+		return ObjectStatus(stats.GetStatus())
+	}
+	return ""
+}
+
+// call runs Process() in the mailbox of the object when the mailboxes are enabled, so it can't run at the same time as an input update;
+// a pass run from inside the mailbox of the object fails after mailboxCommandTimeout
+func (f *flowExecutor) call(object Object) error {
+	m := f.runtime.Mailbox(object.GetUUID())
+	if m == nil {
+		return object.Process()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), mailboxCommandTimeout)
+	defer cancel()
+	var err error
+	if callErr := m.Call(ctx, func() { err = lifecycleCall(object.Process) }); callErr != nil {
+		return callErr
+	}
+	return err
+}
+
+func (f *flowExecutor) record(object Object, d time.Duration, err error) {
+	f.statsMutex.Lock()
+	defer f.statsMutex.Unlock()
+	s, ok := f.stats[object.GetUUID()]
+	if !ok {
+		s = &flowStats{}
+		f.stats[object.GetUUID()] = s
+	}
+	s.count++
+	s.last = d
+	s.total += d
+	if d > s.max {
+		s.max = d
+	}
+	s.err = ""
+	if err != nil {
+		s.err = err.Error()
+	}
+	if updater, ok := object.(StatsUpdater); ok {
+		updater.UpdateStats(s.apply)
+	} else if stats := object.GetStats(); stats != nil {
+		s.apply(stats)
+	}
+}
+
+func (s *flowStats) apply(stats *runtime.ObjectStats) {
+	stats.ProcessCount = s.count
+	stats.LastProcessTime = s.last.Microseconds()
+	stats.AvgProcessTime = (s.total / time.Duration(s.count)).Microseconds()
+	stats.MaxProcessTime = s.max.Microseconds()
+	stats.LastProcessError = s.err
+}
+
+func (f *flowExecutor) Stats(objectUUID string) *runtime.ObjectStats {
+	f.statsMutex.Lock()
+	defer f.statsMutex.Unlock()
+	stats := &runtime.ObjectStats{}
+	if object := f.runtime.GetByUUID(objectUUID); object != nil && object.GetStats() != nil {
+		stats.Status = object.GetStats().GetStatus()
+	}
+	if s, ok := f.stats[objectUUID]; ok {
+		s.apply(stats)
+	}
+	return stats
+}
+
+func (f *flowExecutor) Start() error {
+	f.mutex.Lock()
+	started := f.stop != nil
+	f.mutex.Unlock()
+	if started {
+		return errors.New("flow executor is already started")
+	}
+	if err := f.Build(); err != nil {
+		return err
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.stop = make(chan struct{})
+	f.done = make(chan struct{})
+	go f.loop(f.stop, f.done)
+	return nil
+}
+
+func (f *flowExecutor) loop(stop, done chan struct{}) {
+	defer close(done)
+	var tick <-chan time.Time
+	if f.opts.Mode == FlowTick {
+		ticker := time.NewTicker(f.opts.Interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		var errs []error
+		select {
+		case <-stop:
+			return
+		case <-tick:
+			errs = f.RunOnce()
+		case <-f.wake:
+			if f.opts.Mode == FlowEvent {
+				errs = f.runPending()
+			}
+		}
+		for _, err := range errs {
+			log.Printf("flow: %v", err)
+		}
+	}
+}
+
+func (f *flowExecutor) Stop() {
+	f.mutex.Lock()
+	stop, done := f.stop, f.done
+	f.stop, f.done = nil, nil
+	f.mutex.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
@@ -0,0 +1,204 @@
+package rxlib
+
+import (
+	"errors"
+	"github.com/NubeIO/rxlib/payload"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flowObject only implements what the flow executor needs
+type flowObject struct {
+	testObject
+	connections []*runtime.Connection
+	stats       *runtime.ObjectStats
+	processed   *[]string
+	mutex       *sync.Mutex
+	err         error
+}
+
+func (o *flowObject) GetConnections() []*runtime.Connection { return o.connections }
+func (o *flowObject) GetStats() *runtime.ObjectStats        { return o.stats }
+func (o *flowObject) SetStatus(status ObjectStatus)         { o.stats.Status = string(status) }
+func (o *flowObject) connect(target *flowObject, disable bool) {
+	publisher, subscriber := NewConnection(o.uuid, "out", target.uuid, "in")
+	publisher.Disable, subscriber.Disable = disable, disable
+	o.connections = append(o.connections, publisher)
+	target.connections = append(target.connections, subscriber)
+}
+func (o *flowObject) Process() error {
+	o.mutex.Lock()
+	*o.processed = append(*o.processed, o.uuid)
+	o.mutex.Unlock()
+	time.Sleep(time.Millisecond)
+	return o.err
+}
+
+func newFlowObjects(uuids ...string) (map[string]*flowObject, []Object, *[]string) {
+	processed := &[]string{}
+	mutex := &sync.Mutex{}
+	byUUID := make(map[string]*flowObject)
+	var objects []Object
+	for _, uuid := range uuids {
+		o := &flowObject{testObject: testObject{uuid: uuid}, stats: &runtime.ObjectStats{}, processed: processed, mutex: mutex}
+		byUUID[uuid] = o
+		objects = append(objects, o)
+	}
+	return byUUID, objects, processed
+}
+
+func TestFlowOrder(t *testing.T) {
+	// c -> b -> a, d -> a, and a disabled a -> c that would make a cycle
+	o, objects, processed := newFlowObjects("a", "b", "c", "d")
+	o["c"].connect(o["b"], false)
+	o["b"].connect(o["a"], false)
+	o["d"].connect(o["a"], false)
+	o["a"].connect(o["c"], true)
+	o["b"].err = errors.New("bad input")
+
+	r := &RuntimeImpl{}
+	r.AddObjects(objects)
+	f := newFlowExecutor(r, nil)
+	errs := f.RunOnce()
+	if len(errs) != 1 {
+		t.Fatalf("expected the error of b got: %v", errs)
+	}
+	want := []string{"c", "d", "b", "a"}
+	for i, uuid := range f.Order() {
+		if (*processed)[i] != uuid || uuid != want[i] {
+			t.Fatalf("expected the order %v got: %v processed: %v", want, f.Order(), *processed)
+		}
+	}
+
+	stats := o["a"].GetStats()
+	if stats.ProcessCount != 1 || stats.LastProcessTime < 1000 || stats.Status != string(StatsIdle) {
+		t.Fatalf("expected the process time in the stats got: %+v", stats)
+	}
+	if f.Stats("b").LastProcessError == "" || f.Stats("b").Status != string(StatsError) {
+		t.Fatal("expected the process error in the stats")
+	}
+
+	// enable a -> c
+	o["a"].connections[len(o["a"].connections)-1].Disable = false
+	var cycle *CycleError
+	if err := f.Build(); !errors.As(err, &cycle) || len(cycle.UUIDs) != 4 || cycle.UUIDs[0] != cycle.UUIDs[3] {
+		t.Fatalf("expected a cycle error got: %v", err)
+	}
+}
+
+func TestFlowObjectsChanged(t *testing.T) {
+	// a -> b, then c is added after b and d is deleted
+	o, objects, _ := newFlowObjects("a", "b", "d")
+	o["a"].connect(o["b"], false)
+	r := &RuntimeImpl{}
+	r.AddObjects(objects)
+	r.flow = newFlowExecutor(r, nil)
+	if errs := r.flow.RunOnce(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	c := &flowObject{testObject: testObject{uuid: "c"}, stats: &runtime.ObjectStats{}, processed: o["a"].processed, mutex: o["a"].mutex}
+	o["b"].connect(c, false)
+	if err := r.AddObject(c); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.DeleteByUUID("d"); err != nil {
+		t.Fatal(err)
+	}
+	if order := r.flow.Order(); len(order) != 3 || order[2] != "c" {
+		t.Fatalf("expected the graph to be built again got: %v", order)
+	}
+}
+
+func TestFlowEvent(t *testing.T) {
+	// a -> b -> c, d
+	o, objects, processed := newFlowObjects("a", "b", "c", "d")
+	o["a"].connect(o["b"], false)
+	o["b"].connect(o["c"], false)
+
+	r := &RuntimeImpl{}
+	r.AddObjects(objects)
+	f := newFlowExecutor(r, &FlowOpts{Mode: FlowEvent})
+	if err := f.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer f.Stop()
+	if err := f.Start(); err == nil {
+		t.Fatal("expected an error starting twice")
+	}
+
+	f.Trigger("b")
+	time.Sleep(50 * time.Millisecond)
+	o["a"].mutex.Lock()
+	defer o["a"].mutex.Unlock()
+	if len(*processed) != 2 || (*processed)[0] != "b" || (*processed)[1] != "c" {
+		t.Fatalf("expected b and downstream c to be processed got: %v", *processed)
+	}
+}
+
+// statusObject sets its own error status in Process()
+type statusObject struct {
+	*flowObject
+}
+
+func (o *statusObject) Process() error {
+	o.SetStatus(StatsError)
+	return nil
+}
+
+func TestFlowKeepsObjectStatus(t *testing.T) {
+	o, _, _ := newFlowObjects("a")
+	object := &statusObject{flowObject: o["a"]}
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{object})
+	f := newFlowExecutor(r, nil)
+	if errs := f.RunOnce(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if status := object.GetStats().Status; status != string(StatsError) {
+		t.Fatalf("expected the status set by the object to be kept got: %s", status)
+	}
+}
+
+func TestFlowEventInputs(t *testing.T) {
+	// a -> c, an input update on a processes a and then c
+	b := newTestBus()
+	a, c := newBaseAdd("a", b), newBaseAdd("c", b)
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{a, c})
+	if err := a.NewOutputConnection("out", "c", "in"); err != nil {
+		t.Fatal(err)
+	}
+	r.flow = newFlowExecutor(r, &FlowOpts{Mode: FlowEvent})
+	if err := r.flow.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer r.flow.Stop()
+
+	_ = a.InvokePayload(&payload.Payload{PortValue: &runtime.PortValue{PortID: "in", FloatValue: ptr(1.0)}})
+	waitFor(t, func() bool { return c.GetPortValue("out").GetFloatValue() == 3 })
+}
+
+func TestFlowStatsBaseObject(t *testing.T) {
+	// the executor writes the timings under the object lock, so reading the stats while it runs doesn't race
+	a := newBaseAdd("a", newTestBus())
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{a})
+	f := newFlowExecutor(r, nil)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			f.RunOnce()
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		_ = a.GetStats().GetProcessCount()
+		_ = f.Stats("a")
+	}
+	<-done
+	if stats := a.GetStats(); stats.ProcessCount != 10 || stats.Status != string(StatsIdle) {
+		t.Fatalf("expected 10 idle runs got: %d %s", stats.ProcessCount, stats.Status)
+	}
+}
@@ -0,0 +1,290 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// OverflowPolicy decides what happens when an async handler queue is full
+	OverflowPolicy int
+
+	// QueueOptions is the async queue config of a handler
+	QueueOptions struct {
+		// Size is the max number of events waiting for the handler
+		Size int
+
+		// Overflow policy when the queue is full
+		Overflow OverflowPolicy
+	}
+
+	// HandlerMetrics are the async delivery stats of a handler
+	HandlerMetrics struct {
+		Key         string        `json:"key"`
+		QueueDepth  int           `json:"queueDepth"`
+		QueueSize   int           `json:"queueSize"`
+		Delivered   uint64        `json:"delivered"`
+		Dropped     uint64        `json:"dropped"`
+		LastLatency time.Duration `json:"lastLatency"`
+		AvgLatency  time.Duration `json:"avgLatency"`
+		MaxLatency  time.Duration `json:"maxLatency"`
+	}
+
+	job struct {
+		ctx context.Context
+		e   Event
+	}
+
+	// worker delivers the queued events to a single handler in its own goroutine
+	worker struct {
+		handler Handler
+		opts    QueueOptions
+		mutex   sync.RWMutex
+		closed  bool
+		queue   chan job
+		done    chan struct{}
+
+		delivered    atomic.Uint64
+		dropped      atomic.Uint64
+		lastLatency  atomic.Int64
+		maxLatency   atomic.Int64
+		totalLatency atomic.Int64
+	}
+)
+
+const (
+	// Block waits for space in the queue, or until the emit context is done
+	Block OverflowPolicy = iota
+
+	// DropOldest removes the oldest queued event to make space for the new one
+	DropOldest
+
+	// DropNewest drops the event being emitted
+	DropNewest
+)
+
+// DefaultQueueSize is used when a handler has no queue size set
+const DefaultQueueSize = 100
+
+// ErrQueueClosed is returned when emitting to a handler that is being deregistered
+var ErrQueueClosed = fmt.Errorf("bus: handler queue is closed")
+
+// String returns the policy name
+func (p OverflowPolicy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropOldest:
+		return "drop-oldest"
+	case DropNewest:
+		return "drop-newest"
+	default:
+		return "unknown"
+	}
+}
+
+// SetQueueOptions sets the default queue options for the handlers that don't
+// set their own
+func (b *Bus) SetQueueOptions(opts QueueOptions) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.queueOptions = opts
+}
+
+// EmitAsync inits a new event and queues it for each of the interested in
+// handlers, each handler processes its queue in its own goroutine so a slow
+// handler does not block the publisher
+func (b *Bus) EmitAsync(ctx context.Context, topic string, data interface{}) error {
+	ctx, e := b.newEvent(ctx, topic, data)
+	handlers, err := b.route(e)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, h := range handlers {
+		w := b.worker(h)
+		if w == nil {
+			continue // deregistered since the topic lookup
+		}
+		if err := w.enqueue(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("bus: topic(%s) async emit: %v", topic, errs)
+	}
+	return nil
+}
+
+// HandlerMetrics returns the async delivery stats of a handler
+func (b *Bus) HandlerMetrics(handlerKey string) (*HandlerMetrics, bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	w, ok := b.workers[handlerKey]
+	if !ok {
+		return nil, false
+	}
+	return w.metrics(), true
+}
+
+// Metrics returns the async delivery stats of all the handlers
+func (b *Bus) Metrics() []*HandlerMetrics {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	out := make([]*HandlerMetrics, 0, len(b.workers))
+	for _, w := range b.workers {
+		out = append(out, w.metrics())
+	}
+	return out
+}
+
+// Close stops all the async handler queues, waiting for the queued events to
+// be delivered
+func (b *Bus) Close() {
+	b.mutex.Lock()
+	workers := b.workers
+	b.workers = make(map[string]*worker)
+	b.mutex.Unlock()
+
+	for _, w := range workers {
+		w.close()
+	}
+}
+
+func (b *Bus) worker(h Handler) *worker {
+	b.mutex.RLock()
+	w, ok := b.workers[h.key]
+	b.mutex.RUnlock()
+	if ok {
+		return w
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if w, ok = b.workers[h.key]; ok {
+		return w
+	}
+	if _, ok = b.handlers[h.key]; !ok {
+		return nil
+	}
+	opts := b.queueOptions
+	if h.Queue != nil {
+		opts = *h.Queue
+	}
+	if opts.Size <= 0 {
+		opts.Size = DefaultQueueSize
+	}
+	w = newWorker(h, opts)
+	b.workers[h.key] = w
+	return w
+}
+
+// stopWorker is called with the bus mutex held
+func (b *Bus) stopWorker(handlerKey string) {
+	if w, ok := b.workers[handlerKey]; ok {
+		delete(b.workers, handlerKey)
+		go w.close()
+	}
+}
+
+func newWorker(h Handler, opts QueueOptions) *worker {
+	w := &worker{
+		handler: h,
+		opts:    opts,
+		queue:   make(chan job, opts.Size),
+		done:    make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *worker) run() {
+	defer close(w.done)
+	for j := range w.queue {
+		start := time.Now()
+		w.handler.Handle(j.ctx, j.e)
+		latency := int64(time.Since(start))
+		w.delivered.Add(1)
+		w.lastLatency.Store(latency)
+		w.totalLatency.Add(latency)
+		for {
+			max := w.maxLatency.Load()
+			if latency <= max || w.maxLatency.CompareAndSwap(max, latency) {
+				break
+			}
+		}
+	}
+}
+
+func (w *worker) enqueue(ctx context.Context, e Event) error {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	if w.closed {
+		return ErrQueueClosed
+	}
+
+	// the handler runs after Emit has returned, so only keep the values of the emit context
+	j := job{ctx: context.WithoutCancel(ctx), e: e}
+	switch w.opts.Overflow {
+	case DropNewest:
+		select {
+		case w.queue <- j:
+		default:
+			w.dropped.Add(1)
+		}
+	case DropOldest:
+		for {
+			select {
+			case w.queue <- j:
+				return nil
+			default:
+			}
+			select {
+			case <-w.queue:
+				w.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		select {
+		case w.queue <- j:
+		case <-ctx.Done():
+			w.dropped.Add(1)
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (w *worker) close() {
+	w.mutex.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.queue)
+	}
+	w.mutex.Unlock()
+	<-w.done
+}
+
+func (w *worker) metrics() *HandlerMetrics {
+	m := &HandlerMetrics{
+		Key:         w.handler.key,
+		QueueDepth:  len(w.queue),
+		QueueSize:   w.opts.Size,
+		Delivered:   w.delivered.Load(),
+		Dropped:     w.dropped.Load(),
+		LastLatency: time.Duration(w.lastLatency.Load()),
+		MaxLatency:  time.Duration(w.maxLatency.Load()),
+	}
+	if m.Delivered > 0 {
+		m.AvgLatency = time.Duration(w.totalLatency.Load() / int64(m.Delivered))
+	}
+	return m
+}
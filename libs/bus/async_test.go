@@ -0,0 +1,127 @@
+package bus
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newAsyncBus(t *testing.T, topic string, queue *QueueOptions, handle func(ctx context.Context, e Event)) *Bus {
+	b, err := NewBus(Next(uuid.NewString))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.RegisterTopics(topic)
+	b.RegisterHandler("h", Handler{Handle: handle, Matcher: topic, Queue: queue})
+	return b
+}
+
+func TestEmitAsync_DropNewest(t *testing.T) {
+	release := make(chan struct{})
+	var mutex sync.Mutex
+	var got []interface{}
+	b := newAsyncBus(t, "a", &QueueOptions{Size: 2, Overflow: DropNewest}, func(ctx context.Context, e Event) {
+		<-release
+		mutex.Lock()
+		got = append(got, e.Data)
+		mutex.Unlock()
+	})
+
+	// 1 is taken by the worker, 2 and 3 are queued, 4 and 5 are dropped
+	for i := 1; i <= 5; i++ {
+		if err := b.EmitAsync(context.Background(), "a", i); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	close(release)
+	b.Close()
+
+	if len(got) != 3 || got[2] != 3 {
+		t.Fatalf("expected events 1,2,3 got: %v", got)
+	}
+	m, ok := b.HandlerMetrics("h")
+	if ok {
+		t.Fatalf("expected the metrics to be removed on close got: %+v", m)
+	}
+}
+
+func TestEmitAsync_DropOldest(t *testing.T) {
+	release := make(chan struct{})
+	var got []interface{}
+	b := newAsyncBus(t, "a", &QueueOptions{Size: 2, Overflow: DropOldest}, func(ctx context.Context, e Event) {
+		<-release
+		got = append(got, e.Data)
+	})
+
+	for i := 1; i <= 5; i++ {
+		if err := b.EmitAsync(context.Background(), "a", i); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	m, _ := b.HandlerMetrics("h")
+	if m.Dropped != 2 || m.QueueDepth != 2 {
+		t.Fatalf("expected 2 dropped and 2 queued got: %+v", m)
+	}
+	close(release)
+	b.Close()
+
+	if len(got) != 3 || got[1] != 4 || got[2] != 5 {
+		t.Fatalf("expected events 1,4,5 got: %v", got)
+	}
+}
+
+func TestEmitAsync_Block(t *testing.T) {
+	release := make(chan struct{})
+	b := newAsyncBus(t, "a", &QueueOptions{Size: 1, Overflow: Block}, func(ctx context.Context, e Event) {
+		<-release
+	})
+
+	ctx := context.Background()
+	_ = b.EmitAsync(ctx, "a", 1) // taken by the worker
+	time.Sleep(5 * time.Millisecond)
+	_ = b.EmitAsync(ctx, "a", 2) // queued
+
+	timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if err := b.EmitAsync(timeout, "a", 3); err == nil {
+		t.Fatal("expected the emit to block until the context is done")
+	}
+	close(release)
+	b.Close()
+}
+
+func TestEmitAsync_Metrics(t *testing.T) {
+	done := make(chan struct{})
+	b := newAsyncBus(t, "a", nil, func(ctx context.Context, e Event) {
+		time.Sleep(10 * time.Millisecond)
+		if _, ok := ctx.Value(CtxKeyTxID).(string); !ok {
+			t.Error("expected the tx id in the handler context")
+		}
+		close(done)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := b.EmitAsync(ctx, "a", 1); err != nil {
+		t.Fatal(err)
+	}
+	cancel() // the handler still runs after the emit context is cancelled
+	<-done
+	time.Sleep(5 * time.Millisecond)
+
+	m, ok := b.HandlerMetrics("h")
+	if !ok {
+		t.Fatal("expected metrics for the handler")
+	}
+	if m.Delivered != 1 || m.QueueSize != DefaultQueueSize || m.MaxLatency < 10*time.Millisecond {
+		t.Fatalf("unexpected metrics: %+v", m)
+	}
+
+	b.DeregisterHandler("h")
+	if _, ok := b.HandlerMetrics("h"); ok {
+		t.Fatal("expected the worker to be stopped on deregister")
+	}
+}
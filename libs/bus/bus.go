@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -15,6 +16,21 @@ type (
 		idgen    Next
 		topics   map[string][]Handler
 		handlers map[string]Handler
+		filters  *topicTrie
+
+		// handlers of the unregistered topics, see SetAutoRoute
+		autoRoute bool
+		routes    map[string][]Handler
+
+		// async delivery, see EmitAsync
+		queueOptions QueueOptions
+		workers      map[string]*worker
+
+		// retained events, see SetRetainOptions
+		retainMutex   sync.RWMutex
+		retainEnabled atomic.Bool // read without the retainMutex so an emit only locks when retain is enabled
+		retainOptions RetainOptions
+		retained      map[string][]Event
 	}
 
 	// Next is a sequential unique id generator func type
@@ -44,6 +60,16 @@ type (
 
 		// topic matcher as regex pattern
 		Matcher string
+
+		// Filter is an MQTT style topic filter with + and # wildcards, eg:
+		// r/req/v1/cloud/+/plain/#; it is used instead of the Matcher when set
+		Filter string
+
+		// Queue options for async delivery, the bus defaults are used when nil
+		Queue *QueueOptions
+
+		// compiled Matcher, nil if the pattern is invalid
+		re *regexp.Regexp
 	}
 
 	// EventOption is a function type to mutate event fields
@@ -75,6 +101,10 @@ func NewBus(g IDGenerator) (*Bus, error) {
 		idgen:    g.Generate,
 		topics:   make(map[string][]Handler),
 		handlers: make(map[string]Handler),
+		filters:  newTopicTrie(),
+		routes:   make(map[string][]Handler),
+		workers:  make(map[string]*worker),
+		retained: make(map[string][]Event),
 	}, nil
 }
 
@@ -113,28 +143,10 @@ func WithOccurredAt(time time.Time) EventOption {
 // Emit inits a new event and delivers to the interested in handlers with
 // sync safety
 func (b *Bus) Emit(ctx context.Context, topic string, data interface{}) error {
-	b.mutex.RLock()
-	handlers, ok := b.topics[topic]
-	b.mutex.RUnlock()
-
-	if !ok {
-		return fmt.Errorf("bus: topic(%s) not found", topic)
-	}
-
-	source, _ := ctx.Value(CtxKeySource).(string)
-	txID, _ := ctx.Value(CtxKeyTxID).(string)
-	if txID == empty {
-		txID = b.idgen()
-		ctx = context.WithValue(ctx, CtxKeyTxID, txID)
-	}
-
-	e := Event{
-		ID:         b.idgen(),
-		Topic:      topic,
-		Data:       data,
-		OccurredAt: time.Now(),
-		TxID:       txID,
-		Source:     source,
+	ctx, e := b.newEvent(ctx, topic, data)
+	handlers, err := b.route(e)
+	if err != nil {
+		return err
 	}
 
 	for _, h := range handlers {
@@ -147,14 +159,6 @@ func (b *Bus) Emit(ctx context.Context, topic string, data interface{}) error {
 // EmitWithOpts inits a new event and delivers to the interested in handlers
 // with sync safety and options
 func (b *Bus) EmitWithOpts(ctx context.Context, topic string, data interface{}, opts ...EventOption) error {
-	b.mutex.RLock()
-	handlers, ok := b.topics[topic]
-	b.mutex.RUnlock()
-
-	if !ok {
-		return fmt.Errorf("bus: topic(%s) not found", topic)
-	}
-
 	e := Event{Topic: topic, Data: data}
 	for _, o := range opts {
 		e = o(e)
@@ -170,6 +174,11 @@ func (b *Bus) EmitWithOpts(ctx context.Context, topic string, data interface{},
 		e.OccurredAt = time.Now()
 	}
 
+	handlers, err := b.route(e)
+	if err != nil {
+		return err
+	}
+
 	for _, h := range handlers {
 		h.Handle(ctx, e)
 	}
@@ -204,11 +213,12 @@ func (b *Bus) RegisterTopics(topics ...string) {
 // DeregisterTopics deletes topic
 func (b *Bus) DeregisterTopics(topics ...string) {
 	b.mutex.Lock()
-	defer b.mutex.Unlock()
-
 	for _, n := range topics {
 		b.deregisterTopic(n)
 	}
+	b.mutex.Unlock()
+
+	b.ClearRetained(topics...)
 }
 
 // TopicHandlerKeys returns all handlers for the topic
@@ -248,13 +258,24 @@ func (b *Bus) HandlerTopicSubscriptions(handlerKey string) []string {
 	return b.handlerTopicSubscriptions(handlerKey)
 }
 
-// RegisterHandler re/register the handler to the registry
+// RegisterHandler re/register the handler to the registry, a handler with an
+// invalid Filter or Matcher is not registered, see AddHandler for the error
 func (b *Bus) RegisterHandler(key string, h Handler) {
+	_ = b.AddHandler(key, h)
+}
+
+// AddHandler validates the Filter or Matcher of the handler and re/register
+// it to the registry
+func (b *Bus) AddHandler(key string, h Handler) error {
+	if err := h.validate(); err != nil {
+		return err
+	}
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
 	h.key = key
 	b.registerHandler(h)
+	return nil
 }
 
 // DeregisterHandler deletes handler from the registry
@@ -265,6 +286,24 @@ func (b *Bus) DeregisterHandler(key string) {
 	b.deregisterHandler(key)
 }
 
+func (b *Bus) newEvent(ctx context.Context, topic string, data interface{}) (context.Context, Event) {
+	source, _ := ctx.Value(CtxKeySource).(string)
+	txID, _ := ctx.Value(CtxKeyTxID).(string)
+	if txID == empty {
+		txID = b.idgen()
+		ctx = context.WithValue(ctx, CtxKeyTxID, txID)
+	}
+
+	return ctx, Event{
+		ID:         b.idgen(),
+		Topic:      topic,
+		Data:       data,
+		OccurredAt: time.Now(),
+		TxID:       txID,
+		Source:     source,
+	}
+}
+
 // Generate is an implementation of IDGenerator for bus.Next fn type
 func (n Next) Generate() string {
 	return n()
@@ -272,6 +311,12 @@ func (n Next) Generate() string {
 
 func (b *Bus) registerHandler(h Handler) {
 	b.deregisterHandler(h.key)
+	b.invalidateRoutes()
+	if h.Filter != empty {
+		b.filters.add(h.Filter, h.key)
+	} else {
+		h.re, _ = regexp.Compile(h.Matcher)
+	}
 	b.handlers[h.key] = h
 	for _, t := range b.handlerTopicSubscriptions(h.key) {
 		b.registerTopicHandler(t, h)
@@ -279,12 +324,17 @@ func (b *Bus) registerHandler(h Handler) {
 }
 
 func (b *Bus) deregisterHandler(handlerKey string) {
-	if _, ok := b.handlers[handlerKey]; ok {
+	if h, ok := b.handlers[handlerKey]; ok {
 		for _, t := range b.handlerTopicSubscriptions(handlerKey) {
 			b.deregisterTopicHandler(t, handlerKey)
 		}
+		if h.Filter != empty {
+			b.filters.remove(h.Filter, handlerKey)
+		}
 		delete(b.handlers, handlerKey)
+		b.invalidateRoutes()
 	}
+	b.stopWorker(handlerKey)
 }
 
 func (b *Bus) registerTopicHandler(topic string, h Handler) {
@@ -307,6 +357,7 @@ func (b *Bus) registerTopic(topic string) {
 		return
 	}
 
+	delete(b.routes, topic)
 	b.topics[topic] = b.buildHandlers(topic)
 }
 
@@ -317,10 +368,13 @@ func (b *Bus) deregisterTopic(topic string) {
 func (b *Bus) buildHandlers(topic string) []Handler {
 	handlers := make([]Handler, 0)
 	for _, h := range b.handlers {
-		if matched, _ := regexp.MatchString(h.Matcher, topic); matched {
+		if h.Filter == empty && h.matches(topic) {
 			handlers = append(handlers, h)
 		}
 	}
+	for _, key := range b.filters.match(topic) {
+		handlers = append(handlers, b.handlers[key])
+	}
 	return handlers
 }
 
@@ -332,9 +386,26 @@ func (b *Bus) handlerTopicSubscriptions(handlerKey string) []string {
 	}
 
 	for topic := range b.topics {
-		if matched, _ := regexp.MatchString(h.Matcher, topic); matched {
+		if h.matches(topic) {
 			subscriptions = append(subscriptions, topic)
 		}
 	}
 	return subscriptions
 }
+
+func (h Handler) validate() error {
+	if h.Filter != empty {
+		return ValidateFilter(h.Filter)
+	}
+	if _, err := regexp.Compile(h.Matcher); err != nil {
+		return fmt.Errorf("bus: handler matcher(%s) err: %v", h.Matcher, err)
+	}
+	return nil
+}
+
+func (h Handler) matches(topic string) bool {
+	if h.Filter != empty {
+		return MatchFilter(h.Filter, topic)
+	}
+	return h.re != nil && h.re.MatchString(topic)
+}
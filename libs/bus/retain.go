@@ -0,0 +1,220 @@
+package bus
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+type (
+	// RetainOptions configures the events the bus keeps per topic
+	RetainOptions struct {
+		// Enabled keeps the last event of each topic, like an MQTT retained message
+		Enabled bool
+
+		// ReplaySize is the max number of events kept per topic for replay, the
+		// last event is always kept when enabled
+		ReplaySize int
+	}
+
+	// Replay selects the retained events delivered to a handler when it is registered
+	Replay struct {
+		// Latest delivers the last event of each matching topic
+		Latest bool `json:"latest"`
+
+		// Last delivers up to the last N events of each matching topic, oldest first
+		Last int `json:"last"`
+	}
+
+	// replayGate holds the live events of a handler while its retained events
+	// are replayed, so they are delivered after the older retained events
+	replayGate struct {
+		mutex     sync.Mutex
+		replaying bool
+		pending   []pendingEvent
+	}
+
+	pendingEvent struct {
+		ctx context.Context
+		e   Event
+	}
+)
+
+// SetRetainOptions sets what events are retained, existing retained events
+// are trimmed to the new replay size
+func (b *Bus) SetRetainOptions(opts RetainOptions) {
+	b.retainMutex.Lock()
+	defer b.retainMutex.Unlock()
+
+	b.retainOptions = opts
+	b.retainEnabled.Store(opts.Enabled)
+	if !opts.Enabled {
+		b.retained = make(map[string][]Event)
+		return
+	}
+	for topic, events := range b.retained {
+		b.retained[topic] = trimEvents(events, opts.size())
+	}
+}
+
+// RegisterHandlerWithReplay re/register the handler and delivers the retained
+// events of the topics it matches, an event emitted while registering is
+// either replayed or delivered, never both. The events emitted during the
+// replay are delivered after it, so the handler gets them in order
+func (b *Bus) RegisterHandlerWithReplay(key string, h Handler, replay Replay) error {
+	handle := h.Handle
+	gate := &replayGate{replaying: true}
+	h.Handle = func(ctx context.Context, e Event) {
+		if gate.hold(ctx, e) {
+			return
+		}
+		handle(ctx, e)
+	}
+
+	b.retainMutex.Lock()
+	if err := b.AddHandler(key, h); err != nil {
+		b.retainMutex.Unlock()
+		return err
+	}
+	b.mutex.RLock()
+	h = b.handlers[key]
+	b.mutex.RUnlock()
+
+	topics := make([]string, 0)
+	for topic := range b.retained {
+		if h.matches(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	var events []Event
+	for _, topic := range topics {
+		events = append(events, lastEvents(b.retained[topic], replay.count())...)
+	}
+	b.retainMutex.Unlock()
+
+	for _, e := range events {
+		handle(context.WithValue(context.Background(), CtxKeyTxID, e.TxID), e)
+	}
+	// the held events are delivered here and not by the emitters, so a handler
+	// that emits to its own topic during the replay doesn't block
+	for pending := gate.next(); len(pending) > 0; pending = gate.next() {
+		for _, p := range pending {
+			handle(p.ctx, p.e)
+		}
+	}
+	return nil
+}
+
+// hold keeps the event if the replay is not done, it returns false once the
+// event can be delivered
+func (g *replayGate) hold(ctx context.Context, e Event) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if !g.replaying {
+		return false
+	}
+	g.pending = append(g.pending, pendingEvent{ctx: ctx, e: e})
+	return true
+}
+
+// next returns the held events, the replay is done when there are none left
+func (g *replayGate) next() []pendingEvent {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	pending := g.pending
+	g.pending = nil
+	if len(pending) == 0 {
+		g.replaying = false
+	}
+	return pending
+}
+
+// Latest returns the retained last event of a topic
+func (b *Bus) Latest(topic string) (Event, bool) {
+	b.retainMutex.RLock()
+	defer b.retainMutex.RUnlock()
+
+	events := b.retained[topic]
+	if len(events) == 0 {
+		return Event{}, false
+	}
+	return events[len(events)-1], true
+}
+
+// Replay returns up to the last n retained events of a topic, oldest first
+func (b *Bus) Replay(topic string, n int) []Event {
+	b.retainMutex.RLock()
+	defer b.retainMutex.RUnlock()
+
+	return lastEvents(b.retained[topic], n)
+}
+
+// ClearRetained deletes the retained events of the topics
+func (b *Bus) ClearRetained(topics ...string) {
+	b.retainMutex.Lock()
+	defer b.retainMutex.Unlock()
+
+	for _, topic := range topics {
+		delete(b.retained, topic)
+	}
+}
+
+// route returns the handlers of the event topic and retains the event, both
+// under the retain lock so a handler registered with replay can't miss it.
+// When retain is disabled the lock is not taken, so publishers don't wait
+// on each other
+func (b *Bus) route(e Event) ([]Handler, error) {
+	if !b.retainEnabled.Load() {
+		return b.topicHandlers(e.Topic)
+	}
+	b.retainMutex.Lock()
+	defer b.retainMutex.Unlock()
+
+	handlers, err := b.topicHandlers(e.Topic)
+	if err != nil {
+		return nil, err
+	}
+	if b.retainOptions.Enabled {
+		b.retained[e.Topic] = trimEvents(append(b.retained[e.Topic], e), b.retainOptions.size())
+	}
+	return handlers, nil
+}
+
+func (o RetainOptions) size() int {
+	if o.ReplaySize < 1 {
+		return 1
+	}
+	return o.ReplaySize
+}
+
+func (r Replay) count() int {
+	if r.Last > 0 {
+		return r.Last
+	}
+	if r.Latest {
+		return 1
+	}
+	return 0
+}
+
+func trimEvents(events []Event, size int) []Event {
+	if len(events) <= size {
+		return events
+	}
+	return append(events[:0], events[len(events)-size:]...)
+}
+
+func lastEvents(events []Event, n int) []Event {
+	if n <= 0 || len(events) == 0 {
+		return nil
+	}
+	if n > len(events) {
+		n = len(events)
+	}
+	out := make([]Event, n)
+	copy(out, events[len(events)-n:])
+	return out
+}
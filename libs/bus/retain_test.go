@@ -0,0 +1,99 @@
+package bus
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestBus_Retain(t *testing.T) {
+	b, err := NewBus(Next(uuid.NewString))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.SetAutoRoute(true)
+	b.SetRetainOptions(RetainOptions{Enabled: true, ReplaySize: 3})
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		_ = b.Emit(ctx, "obj/a/out", i)
+	}
+	_ = b.EmitWithOpts(ctx, "obj/b/out", 10)
+
+	if e, ok := b.Latest("obj/a/out"); !ok || e.Data != 5 {
+		t.Fatalf("expected the latest event to be 5 got: %+v", e)
+	}
+	if events := b.Replay("obj/a/out", 10); len(events) != 3 || events[0].Data != 3 {
+		t.Fatalf("expected the last 3 events got: %+v", events)
+	}
+
+	// a late handler gets the latest of each matching topic
+	var got []interface{}
+	handle := func(ctx context.Context, e Event) { got = append(got, e.Data) }
+	b.RegisterHandlerWithReplay("late", Handler{Handle: handle, Filter: "obj/+/out"}, Replay{Latest: true})
+	if len(got) != 2 || got[0] != 5 || got[1] != 10 {
+		t.Fatalf("expected the latest of each topic got: %v", got)
+	}
+
+	got = nil
+	b.RegisterHandlerWithReplay("late", Handler{Handle: handle, Filter: "obj/a/out"}, Replay{Last: 2})
+	if len(got) != 2 || got[0] != 4 || got[1] != 5 {
+		t.Fatalf("expected the last 2 events got: %v", got)
+	}
+
+	// live events after the replay
+	got = nil
+	_ = b.Emit(ctx, "obj/a/out", 6)
+	if len(got) != 1 || got[0] != 6 {
+		t.Fatalf("expected the live event got: %v", got)
+	}
+
+	b.SetRetainOptions(RetainOptions{Enabled: true})
+	if events := b.Replay("obj/a/out", 10); len(events) != 1 {
+		t.Fatalf("expected the replay to be trimmed to the latest got: %d", len(events))
+	}
+	b.ClearRetained("obj/a/out")
+	if _, ok := b.Latest("obj/a/out"); ok {
+		t.Fatal("expected the retained event to be cleared")
+	}
+
+	// nothing is retained once it is disabled
+	b.SetRetainOptions(RetainOptions{})
+	_ = b.Emit(ctx, "obj/a/out", 7)
+	if _, ok := b.Latest("obj/a/out"); ok {
+		t.Fatal("expected no retained event when retain is disabled")
+	}
+	if err := b.RegisterHandlerWithReplay("bad", Handler{Handle: handle, Filter: "obj/#/out"}, Replay{Latest: true}); err == nil {
+		t.Fatal("expected an invalid filter to be rejected")
+	}
+}
+
+func TestBus_RetainReplayOrder(t *testing.T) {
+	b, err := NewBus(Next(uuid.NewString))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.SetAutoRoute(true)
+	b.SetRetainOptions(RetainOptions{Enabled: true, ReplaySize: 2})
+	ctx := context.Background()
+	_ = b.Emit(ctx, "obj/a/out", 1)
+	_ = b.Emit(ctx, "obj/a/out", 2)
+
+	// a live event emitted during the replay is delivered after the retained events
+	var got []interface{}
+	handle := func(ctx context.Context, e Event) {
+		got = append(got, e.Data)
+		if e.Data == 1 {
+			_ = b.Emit(ctx, "obj/a/out", 3)
+		}
+	}
+	if err := b.RegisterHandlerWithReplay("late", Handler{Handle: handle, Filter: "obj/a/out"}, Replay{Last: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("expected the events in order got: %v", got)
+	}
+	_ = b.Emit(ctx, "obj/a/out", 4)
+	if len(got) != 4 || got[3] != 4 {
+		t.Fatalf("expected the live event after the replay got: %v", got)
+	}
+}
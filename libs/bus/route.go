@@ -0,0 +1,59 @@
+package bus
+
+import "fmt"
+
+// MaxRoutes is the max number of unregistered topics to keep the resolved
+// handlers for, the cache is reset when it is full
+const MaxRoutes = 10000
+
+// SetAutoRoute when enabled, emitting to a topic that was not registered
+// resolves the matching handlers instead of returning an error, the handlers
+// are cached per topic until a handler is registered or deregistered
+func (b *Bus) SetAutoRoute(enabled bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.autoRoute = enabled
+	b.routes = make(map[string][]Handler)
+}
+
+// topicHandlers returns the handlers of a registered topic, or the resolved
+// handlers of an unregistered topic when auto route is enabled
+func (b *Bus) topicHandlers(topic string) ([]Handler, error) {
+	b.mutex.RLock()
+	handlers, ok := b.topics[topic]
+	if !ok && b.autoRoute {
+		handlers, ok = b.routes[topic]
+	}
+	autoRoute := b.autoRoute
+	b.mutex.RUnlock()
+
+	if ok {
+		return handlers, nil
+	}
+	if !autoRoute {
+		return nil, fmt.Errorf("bus: topic(%s) not found", topic)
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if handlers, ok = b.topics[topic]; ok {
+		return handlers, nil
+	}
+	if handlers, ok = b.routes[topic]; ok {
+		return handlers, nil
+	}
+	handlers = b.buildHandlers(topic)
+	if len(b.routes) >= MaxRoutes {
+		b.routes = make(map[string][]Handler)
+	}
+	b.routes[topic] = handlers
+	return handlers, nil
+}
+
+// invalidateRoutes is called with the bus mutex held when the handlers change
+func (b *Bus) invalidateRoutes() {
+	if len(b.routes) > 0 {
+		b.routes = make(map[string][]Handler)
+	}
+}
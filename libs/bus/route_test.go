@@ -0,0 +1,54 @@
+package bus
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBus_AutoRoute(t *testing.T) {
+	b, err := NewBus(Next(uuid.NewString))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var count atomic.Int32
+	handle := func(ctx context.Context, e Event) { count.Add(1) }
+	b.RegisterHandler("ports", Handler{Handle: handle, Filter: "obj/+/port/#"})
+
+	topic := "obj/abc/port/out"
+	if err := b.Emit(context.Background(), topic, nil); err == nil {
+		t.Fatal("expected topic not found without auto route")
+	}
+
+	b.SetAutoRoute(true)
+	if err := b.Emit(context.Background(), topic, nil); err != nil {
+		t.Fatal(err)
+	}
+	if count.Load() != 1 || len(b.routes) != 1 {
+		t.Fatalf("expected the handler to be called and the route cached got: %d %d", count.Load(), len(b.routes))
+	}
+	if len(b.Topics()) != 0 {
+		t.Fatal("expected the auto routed topic to not be registered")
+	}
+
+	// a new handler invalidates the cache
+	b.RegisterHandler("out", Handler{Handle: handle, Matcher: "/out$"})
+	if len(b.routes) != 0 {
+		t.Fatal("expected the route cache to be reset")
+	}
+	if err := b.EmitAsync(context.Background(), topic, nil); err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+	if count.Load() != 3 {
+		t.Fatalf("expected both handlers to be called got: %d", count.Load())
+	}
+
+	b.DeregisterHandler("out")
+	b.DeregisterHandler("ports")
+	count.Store(0)
+	if err := b.Emit(context.Background(), topic, nil); err != nil || count.Load() != 0 {
+		t.Fatalf("expected no handlers to be called got: %d err: %v", count.Load(), err)
+	}
+}
@@ -0,0 +1,148 @@
+package bus
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// SingleLevelWildcard matches exactly one topic level, eg: r/+/v1
+	SingleLevelWildcard = "+"
+
+	// MultiLevelWildcard matches the parent and any number of child levels, it must be last, eg: r/req/#
+	MultiLevelWildcard = "#"
+
+	topicSeparator = "/"
+)
+
+type (
+	// topicTrie indexes the MQTT style filters of the handlers by topic level
+	topicTrie struct {
+		root *trieNode
+	}
+
+	trieNode struct {
+		children map[string]*trieNode
+		handlers map[string]struct{}
+	}
+)
+
+func newTopicTrie() *topicTrie {
+	return &topicTrie{root: newTrieNode()}
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{
+		children: make(map[string]*trieNode),
+		handlers: make(map[string]struct{}),
+	}
+}
+
+// ValidateFilter checks an MQTT style topic filter, the wildcards must take a
+// whole level and # can only be the last level
+func ValidateFilter(filter string) error {
+	if filter == empty {
+		return fmt.Errorf("bus: topic filter can't be empty")
+	}
+	levels := strings.Split(filter, topicSeparator)
+	for i, level := range levels {
+		if strings.Contains(level, MultiLevelWildcard) && (level != MultiLevelWildcard || i != len(levels)-1) {
+			return fmt.Errorf("bus: topic filter(%s) # must be the last level", filter)
+		}
+		if strings.Contains(level, SingleLevelWildcard) && level != SingleLevelWildcard {
+			return fmt.Errorf("bus: topic filter(%s) + must take a whole level", filter)
+		}
+	}
+	return nil
+}
+
+// MatchFilter reports whether the topic matches the MQTT style filter
+func MatchFilter(filter, topic string) bool {
+	f := strings.Split(filter, topicSeparator)
+	t := strings.Split(topic, topicSeparator)
+	for i, level := range f {
+		if level == MultiLevelWildcard {
+			return i > 0 || !isSystemTopic(t)
+		}
+		if i >= len(t) {
+			return false
+		}
+		if level == SingleLevelWildcard {
+			if i == 0 && isSystemTopic(t) {
+				return false
+			}
+			continue
+		}
+		if level != t[i] {
+			return false
+		}
+	}
+	return len(f) == len(t)
+}
+
+// isSystemTopic topics starting with $ are not matched by a wildcard on the first level
+func isSystemTopic(levels []string) bool {
+	return strings.HasPrefix(levels[0], "$")
+}
+
+func (t *topicTrie) add(filter, handlerKey string) {
+	node := t.root
+	for _, level := range strings.Split(filter, topicSeparator) {
+		child, ok := node.children[level]
+		if !ok {
+			child = newTrieNode()
+			node.children[level] = child
+		}
+		node = child
+	}
+	node.handlers[handlerKey] = struct{}{}
+}
+
+func (t *topicTrie) remove(filter, handlerKey string) {
+	t.root.remove(strings.Split(filter, topicSeparator), handlerKey)
+}
+
+// remove returns true when the node is empty and can be pruned
+func (n *trieNode) remove(levels []string, handlerKey string) bool {
+	if len(levels) == 0 {
+		delete(n.handlers, handlerKey)
+	} else if child, ok := n.children[levels[0]]; ok && child.remove(levels[1:], handlerKey) {
+		delete(n.children, levels[0])
+	}
+	return len(n.handlers) == 0 && len(n.children) == 0
+}
+
+// match returns the keys of the handlers with a filter matching the topic
+func (t *topicTrie) match(topic string) []string {
+	levels := strings.Split(topic, topicSeparator)
+	var keys []string
+	t.root.match(levels, 0, isSystemTopic(levels), &keys)
+	return keys
+}
+
+func (n *trieNode) match(levels []string, i int, system bool, keys *[]string) {
+	wildcards := !(system && i == 0)
+	if wildcards {
+		if child, ok := n.children[MultiLevelWildcard]; ok {
+			child.collect(keys)
+		}
+	}
+	if i == len(levels) {
+		n.collect(keys)
+		return
+	}
+	if child, ok := n.children[levels[i]]; ok {
+		child.match(levels, i+1, system, keys)
+	}
+	if wildcards {
+		if child, ok := n.children[SingleLevelWildcard]; ok {
+			child.match(levels, i+1, system, keys)
+		}
+	}
+}
+
+func (n *trieNode) collect(keys *[]string) {
+	for key := range n.handlers {
+		*keys = append(*keys, key)
+	}
+}
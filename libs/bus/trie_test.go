@@ -0,0 +1,113 @@
+package bus
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sort"
+	"testing"
+)
+
+func TestMatchFilter(t *testing.T) {
+	tests := []struct {
+		filter, topic string
+		match         bool
+	}{
+		{"r/req/v1/cloud/+/plain/#", "r/req/v1/cloud/global/plain/command/abc/123", true},
+		{"r/req/v1/cloud/+/plain/#", "r/req/v1/cloud/global/json/command", false},
+		{"a/+", "a/b", true},
+		{"a/+", "a/b/c", false},
+		{"a/+", "a", false},
+		{"a/#", "a", true},
+		{"a/#", "a/b/c", true},
+		{"#", "a/b", true},
+		{"+/b", "a/b", true},
+		{"#", "$SYS/b", false},
+		{"+/b", "$SYS/b", false},
+		{"$SYS/#", "$SYS/b", true},
+		{"a/b", "a/b", true},
+		{"a/b", "a/bc", false},
+	}
+	trie := newTopicTrie()
+	for i, tt := range tests {
+		if got := MatchFilter(tt.filter, tt.topic); got != tt.match {
+			t.Errorf("MatchFilter(%s, %s) = %v want %v", tt.filter, tt.topic, got, tt.match)
+		}
+		key := uuid.NewString()
+		trie.add(tt.filter, key)
+		var found bool
+		for _, k := range trie.match(tt.topic) {
+			found = found || k == key
+		}
+		if found != tt.match {
+			t.Errorf("trie match %d (%s, %s) = %v want %v", i, tt.filter, tt.topic, found, tt.match)
+		}
+		trie.remove(tt.filter, key)
+	}
+	if len(trie.root.children) != 0 {
+		t.Fatalf("expected the trie to be pruned got: %d nodes", len(trie.root.children))
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	for _, filter := range []string{"a/b", "a/+/c", "a/#", "#", "+"} {
+		if err := ValidateFilter(filter); err != nil {
+			t.Errorf("expected %s to be valid got: %v", filter, err)
+		}
+	}
+	for _, filter := range []string{"", "a/#/c", "a/b#", "a/b+/c"} {
+		if err := ValidateFilter(filter); err == nil {
+			t.Errorf("expected %s to be invalid", filter)
+		}
+	}
+}
+
+func TestBus_FilterAndMatcher(t *testing.T) {
+	b, err := NewBus(Next(uuid.NewString))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	handle := func(key string) func(ctx context.Context, e Event) {
+		return func(ctx context.Context, e Event) { got = append(got, key) }
+	}
+	b.RegisterTopics("r/req/v1/cloud/a/plain/command", "r/req/v1/cloud/b/json/command")
+	b.RegisterHandler("plain", Handler{Handle: handle("plain"), Filter: "r/req/v1/cloud/+/plain/#"})
+	b.RegisterHandler("all", Handler{Handle: handle("all"), Filter: "r/req/#"})
+	b.RegisterHandler("regex", Handler{Handle: handle("regex"), Matcher: "^r/req/v1/cloud/b/.*"})
+	b.RegisterHandler("invalid", Handler{Handle: handle("invalid"), Matcher: "("})
+	if err := b.AddHandler("bad-filter", Handler{Handle: handle("bad-filter"), Filter: "r/#/cloud"}); err == nil {
+		t.Fatal("expected an invalid filter to be rejected")
+	}
+	if err := b.AddHandler("bad-matcher", Handler{Handle: handle("bad-matcher"), Matcher: "("}); err == nil {
+		t.Fatal("expected an invalid matcher to be rejected")
+	}
+	if len(b.HandlerKeys()) != 3 {
+		t.Fatalf("expected the invalid handlers not to be registered got: %v", b.HandlerKeys())
+	}
+
+	_ = b.Emit(context.Background(), "r/req/v1/cloud/a/plain/command", nil)
+	sort.Strings(got)
+	if len(got) != 2 || got[0] != "all" || got[1] != "plain" {
+		t.Fatalf("expected all and plain got: %v", got)
+	}
+
+	got = nil
+	_ = b.Emit(context.Background(), "r/req/v1/cloud/b/json/command", nil)
+	sort.Strings(got)
+	if len(got) != 2 || got[0] != "all" || got[1] != "regex" {
+		t.Fatalf("expected all and regex got: %v", got)
+	}
+
+	// a topic registered after the handlers
+	b.RegisterTopics("r/req/v1/cloud/c/plain")
+	if keys := b.TopicHandlerKeys("r/req/v1/cloud/c/plain"); len(keys) != 2 {
+		t.Fatalf("expected 2 handlers got: %v", keys)
+	}
+	b.DeregisterHandler("plain")
+	if subs := b.HandlerTopicSubscriptions("all"); len(subs) != 3 {
+		t.Fatalf("expected 3 subscriptions got: %v", subs)
+	}
+	if keys := b.TopicHandlerKeys("r/req/v1/cloud/a/plain/command"); len(keys) != 1 {
+		t.Fatalf("expected only the all handler got: %v", keys)
+	}
+}
@@ -1,21 +1,87 @@
 package event
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/NubeIO/rxlib/libs/bus"
 	"github.com/NubeIO/rxlib/payload"
+	"log"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
+// ErrClosed is returned when publishing after the bus is closed
+var ErrClosed = errors.New("event bus is closed")
+
+type Callback = func(topic string, data *payload.Payload, err error)
+
 type EventBus interface {
-	Subscribe(topic string, callback func(topic string, data *payload.Payload, err error))
+	// Subscribe returns the subscription id, used to Unsubscribe
+	Subscribe(topic string, callback Callback) string
+	// SubscribeWithReplay subscribes and delivers the retained payloads of the topic first, before it returns
+	SubscribeWithReplay(topic string, replay bus.Replay, callback Callback) string
+	// SubscribeWithOpts subscribes with a replay and a TTL, expired payloads are passed with an err wrapping payload.ErrExpired or dropped
+	SubscribeWithOpts(topic string, opts *SubscribeOpts, callback Callback) string
+	// Latest returns the last payload published on the topic
+	Latest(topic string) (*payload.Payload, bool)
 	Publish(topic string, data *payload.Payload) error
-	Unsubscribe(topic string, callback func(topic string, data *payload.Payload, err error))
+	// PublishContext is the same as Publish, but gives up waiting for space in the queue when the ctx is done
+	PublishContext(ctx context.Context, topic string, data *payload.Payload) error
+	// Unsubscribe returns false if the subscription was not found
+	Unsubscribe(subscriptionID string) bool
+	// Close stops accepting new payloads and hands the queued payloads to the subscribers, it does not wait for the callbacks
+	// so it can be called from one; use Drain to wait for them
+	Close() error
+	// Drain waits for the callbacks that are running, or until the ctx is done; it must not be called from a callback
+	Drain(ctx context.Context) error
+}
+
+// Logger is used for the bus logs, eg: a *log.Logger
+type Logger interface {
+	Printf(format string, v ...any)
 }
 
 type eventBus struct {
-	subscribers map[string][]func(topic string, data *payload.Payload, err error)
-	pubChannel  chan *eventMessage
-	lock        sync.RWMutex
+	subscribers   map[string][]*subscriber
+	subscriptions map[string]*subscriber // by id
+	pubChannel    chan *eventMessage
+	lock          sync.RWMutex
+	retained      map[string][]*payload.Payload
+	replaySize    int
+	logger        Logger
+	nextID        atomic.Uint64
+	pubLock       sync.RWMutex // guards closed, so publishing can't send on the closed channel
+	closed        bool
+	done          chan struct{}
+	callbacks     sync.WaitGroup
+}
+
+type Options struct {
+	Context    context.Context // the bus is closed when the context is done
+	Logger     Logger          // defaults to the std logger
+	ReplaySize int             // how many payloads are kept per topic for replay; the last payload is always kept
+}
+
+type SubscribeOpts struct {
+	Context     context.Context // unsubscribe when the context is done
+	Replay      bus.Replay
+	TTL         time.Duration // payloads published longer ago than the TTL are expired for the subscriber
+	DropExpired bool          // drop the expired payloads instead of passing them to the callback with the err
+}
+
+type subscriber struct {
+	id       string
+	topic    string
+	callback Callback
+	opts     *SubscribeOpts
+	stop     func() bool // stops the context unsubscribe
+	logger   Logger
+
+	replayMutex sync.Mutex
+	replaying   bool            // the published payloads are held until the retained payloads are replayed
+	pending     []*eventMessage // the payloads held during the replay, oldest first
 }
 
 type eventMessage struct {
@@ -24,59 +90,252 @@ type eventMessage struct {
 }
 
 func NewEventBus() EventBus {
+	return NewEventBusWithOpts(nil)
+}
+
+func NewEventBusWithOpts(opts *Options) EventBus {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if opts.ReplaySize < 1 {
+		opts.ReplaySize = 1
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.Default()
+	}
 	bus := &eventBus{
-		subscribers: make(map[string][]func(topic string, data *payload.Payload, err error)),
-		pubChannel:  make(chan *eventMessage, 100),
+		subscribers:   make(map[string][]*subscriber),
+		subscriptions: make(map[string]*subscriber),
+		pubChannel:    make(chan *eventMessage, 100),
+		retained:      make(map[string][]*payload.Payload),
+		replaySize:    opts.ReplaySize,
+		logger:        opts.Logger,
+		done:          make(chan struct{}),
 	}
 	go bus.start()
+	if opts.Context != nil {
+		context.AfterFunc(opts.Context, func() {
+			bus.Close()
+		})
+	}
 	return bus
 }
 
-func (b *eventBus) Subscribe(topic string, callback func(topic string, data *payload.Payload, err error)) {
+func (b *eventBus) Subscribe(topic string, callback Callback) string {
+	return b.SubscribeWithOpts(topic, nil, callback)
+}
+
+func (b *eventBus) SubscribeWithReplay(topic string, replay bus.Replay, callback Callback) string {
+	return b.SubscribeWithOpts(topic, &SubscribeOpts{Replay: replay}, callback)
+}
+
+func (b *eventBus) SubscribeWithOpts(topic string, opts *SubscribeOpts, callback Callback) string {
+	if opts == nil {
+		opts = &SubscribeOpts{}
+	}
+	sub := &subscriber{
+		id:       fmt.Sprintf("sub-%d", b.nextID.Add(1)),
+		topic:    topic,
+		callback: callback,
+		opts:     opts,
+		logger:   b.logger,
+	}
 	b.lock.Lock()
-	defer b.lock.Unlock()
-	fmt.Printf("EVENTBUS Subscribe topic: %s \n", topic)
-	b.subscribers[topic] = append(b.subscribers[topic], callback)
-	fmt.Printf("Current Subscribers for topic %s: %v\n", topic, b.subscribers[topic])
+	b.subscribers[topic] = append(b.subscribers[topic], sub)
+	b.subscriptions[sub.id] = sub
+	if opts.Context != nil {
+		sub.stop = context.AfterFunc(opts.Context, func() {
+			b.Unsubscribe(sub.id)
+		})
+	}
+
+	retained := b.retained[topic]
+	replay := opts.Replay
+	n := replay.Last
+	if n <= 0 && replay.Latest {
+		n = 1
+	}
+	if n > len(retained) {
+		n = len(retained)
+	}
+	if n <= 0 {
+		b.lock.Unlock()
+		return sub.id
+	}
+	replayed := make([]*payload.Payload, n)
+	copy(replayed, retained[len(retained)-n:])
+	sub.replaying = true
+	b.callbacks.Add(1)
+	b.lock.Unlock()
+
+	// the replay is delivered before returning, the payloads published meanwhile are held and delivered after it so the
+	// callback gets them in order; the bus lock is not held so the callback can use the bus
+	defer b.callbacks.Done()
+	for _, data := range replayed {
+		sub.deliver(topic, data)
+	}
+	for pending := sub.next(); len(pending) > 0; pending = sub.next() {
+		for _, msg := range pending {
+			sub.deliver(msg.topic, msg.data)
+		}
+	}
+	return sub.id
+}
+
+func (b *eventBus) Latest(topic string) (*payload.Payload, bool) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	retained := b.retained[topic]
+	if len(retained) == 0 {
+		return nil, false
+	}
+	return retained[len(retained)-1], true
 }
 
 func (b *eventBus) Publish(topic string, data *payload.Payload) error {
-	fmt.Printf("EVENTBUS Publish topic: %s\n", topic)
-	b.pubChannel <- &eventMessage{
-		topic: topic,
-		data:  data,
+	return b.PublishContext(context.Background(), topic, data)
+}
+
+func (b *eventBus) PublishContext(ctx context.Context, topic string, data *payload.Payload) error {
+	b.pubLock.RLock()
+	defer b.pubLock.RUnlock()
+	if b.closed {
+		return ErrClosed
+	}
+	if data != nil && data.Timestamp.IsZero() {
+		// the caller's payload is not changed, it can be shared with other publishes
+		stamped := *data
+		stamped.Timestamp = time.Now()
+		data = &stamped
+	}
+	select {
+	case b.pubChannel <- &eventMessage{topic: topic, data: data}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-	return nil
 }
 
-func (b *eventBus) Unsubscribe(topic string, callback func(topic string, data *payload.Payload, err error)) {
+func (b *eventBus) Unsubscribe(subscriptionID string) bool {
 	b.lock.Lock()
 	defer b.lock.Unlock()
-	fmt.Printf("EVENTBUS Unsubscribe topic: %s \n", topic)
-	// Find and remove the specific callback
-	if subscribers, ok := b.subscribers[topic]; ok {
-		for i, sub := range subscribers {
-			if fmt.Sprintf("%p", sub) == fmt.Sprintf("%p", callback) {
-				b.subscribers[topic] = append(subscribers[:i], subscribers[i+1:]...)
-				break
-			}
-		}
-		// If there are no more subscribers for the topic, delete the entry
-		if len(b.subscribers[topic]) == 0 {
-			delete(b.subscribers, topic)
+	sub, ok := b.subscriptions[subscriptionID]
+	if !ok {
+		return false
+	}
+	delete(b.subscriptions, subscriptionID)
+	if sub.stop != nil {
+		sub.stop()
+	}
+	subscribers := b.subscribers[sub.topic]
+	for i, s := range subscribers {
+		if s == sub {
+			b.subscribers[sub.topic] = append(subscribers[:i], subscribers[i+1:]...)
+			break
 		}
 	}
-	fmt.Printf("Subscribers after Unsubscribe for topic %s: %v\n", topic, b.subscribers[topic])
+	// If there are no more subscribers for the topic, delete the entry
+	if len(b.subscribers[sub.topic]) == 0 {
+		delete(b.subscribers, sub.topic)
+	}
+	return true
+}
+
+func (b *eventBus) Close() error {
+	b.pubLock.Lock()
+	if b.closed {
+		b.pubLock.Unlock()
+		<-b.done
+		return nil
+	}
+	b.closed = true
+	close(b.pubChannel)
+	b.pubLock.Unlock()
+
+	<-b.done
+	return nil
+}
+
+func (b *eventBus) Drain(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		b.callbacks.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (b *eventBus) start() {
+	defer close(b.done)
 	for msg := range b.pubChannel {
-		b.lock.RLock()
+		b.lock.Lock()
+		b.retain(msg)
 		if subscribers, ok := b.subscribers[msg.topic]; ok {
-			for _, subscriber := range subscribers {
-				go subscriber(msg.topic, msg.data, nil)
+			for _, sub := range subscribers {
+				b.callbacks.Add(1)
+				go func(sub *subscriber, msg *eventMessage) {
+					defer b.callbacks.Done()
+					if sub.hold(msg) {
+						return
+					}
+					sub.deliver(msg.topic, msg.data)
+				}(sub, msg)
 			}
 		}
-		b.lock.RUnlock()
+		b.lock.Unlock()
+	}
+}
+
+// retain is called with the lock held
+func (b *eventBus) retain(msg *eventMessage) {
+	retained := append(b.retained[msg.topic], msg.data)
+	if len(retained) > b.replaySize {
+		retained = append(retained[:0], retained[len(retained)-b.replaySize:]...)
+	}
+	b.retained[msg.topic] = retained
+}
+
+// hold keeps the payload while the retained payloads are replayed, it returns false once the payload can be delivered
+func (s *subscriber) hold(msg *eventMessage) bool {
+	s.replayMutex.Lock()
+	defer s.replayMutex.Unlock()
+	if !s.replaying {
+		return false
+	}
+	s.pending = append(s.pending, msg)
+	return true
+}
+
+// next returns the held payloads, the replay is done when there are none left
+func (s *subscriber) next() []*eventMessage {
+	s.replayMutex.Lock()
+	defer s.replayMutex.Unlock()
+	pending := s.pending
+	s.pending = nil
+	if len(pending) == 0 {
+		s.replaying = false
+	}
+	return pending
+}
+
+// deliver passes the payload to the callback, checking the expiry first; a panic in the callback is logged
+func (s *subscriber) deliver(topic string, data *payload.Payload) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Printf("event bus: topic: %s subscription: %s callback panic: %v", topic, s.id, r)
+		}
+	}()
+	var err error
+	if data != nil {
+		err = data.CheckExpiry(s.opts.TTL, time.Now())
+	}
+	if err != nil && s.opts.DropExpired {
+		return
 	}
+	s.callback(topic, data, err)
 }
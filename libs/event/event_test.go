@@ -1,8 +1,14 @@
 package event
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/NubeIO/rxlib/libs/bus"
 	"github.com/NubeIO/rxlib/payload"
+	"log"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -10,8 +16,8 @@ import (
 func TestNewEventBus(t *testing.T) {
 	bus := NewEventBus()
 
-	bus.Subscribe("topic1", func(topic string, data *payload.Payload, err error) {
-		fmt.Println("Received on 11111:", data, topic)
+	id := bus.Subscribe("topic1", func(topic string, data *payload.Payload, err error) {
+		t.Log("Received on 11111:", data, topic)
 	})
 
 	bus.Publish("topic1", &payload.Payload{
@@ -27,7 +33,7 @@ func TestNewEventBus(t *testing.T) {
 
 	time.Sleep(1 * time.Second)
 
-	bus.Unsubscribe("topic1")
+	bus.Unsubscribe(id)
 
 }
 
@@ -37,16 +43,24 @@ func TestNewEventBus1000(t *testing.T) {
 	// Start timing
 	start := time.Now()
 
-	// Add 1000 subscriptions
-	for i := 0; i < 10000000; i++ {
-		bus.Subscribe(fmt.Sprintf("topic%d", i), func(topic string, data *payload.Payload, err error) {
-			fmt.Printf("Received on topic%d: %v\n", i, data)
+	// Add 100000 subscriptions
+	count := 100000
+	if testing.Short() {
+		count = 1000
+	}
+	var topic1 string
+	for i := 0; i < count; i++ {
+		id := bus.Subscribe(fmt.Sprintf("topic%d", i), func(topic string, data *payload.Payload, err error) {
+			t.Logf("Received on topic%d: %v", i, data)
 		})
+		if i == 1 {
+			topic1 = id
+		}
 	}
 
 	// Stop timing and print the duration
 	duration := time.Since(start)
-	fmt.Printf("Time taken to add 1000 subscriptions: %v\n", duration)
+	t.Logf("Time taken to add %d subscriptions: %v", count, duration)
 
 	// Test publishing and receiving a message
 	bus.Publish("topic1", &payload.Payload{})
@@ -55,6 +69,171 @@ func TestNewEventBus1000(t *testing.T) {
 	time.Sleep(1 * time.Second)
 
 	// Unsubscribe from topic1
-	bus.Unsubscribe("topic1")
+	bus.Unsubscribe(topic1)
+
+}
+
+type testLogger struct {
+	mutex sync.Mutex
+	logs  []string
+}
+
+func (l *testLogger) Printf(format string, v ...any) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.logs = append(l.logs, fmt.Sprintf(format, v...))
+}
+
+func TestEventBusClose(t *testing.T) {
+	logger := &testLogger{}
+	b := NewEventBusWithOpts(&Options{Logger: logger, ReplaySize: 2})
+
+	var mutex sync.Mutex
+	var got []string
+	id := b.Subscribe("a", func(topic string, data *payload.Payload, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		got = append(got, data.FromPortID)
+	})
+	b.Subscribe("a", func(topic string, data *payload.Payload, err error) {
+		panic("bad callback")
+	})
+	for _, port := range []string{"1", "2", "3"} {
+		if err := b.Publish("a", &payload.Payload{FromPortID: port}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Drain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// everything queued is delivered on close, and the panics don't stop the delivery
+	if len(got) != 3 {
+		t.Fatalf("expected 3 payloads to be delivered got: %v", got)
+	}
+	if len(logger.logs) != 3 || !strings.Contains(logger.logs[0], "bad callback") {
+		t.Fatalf("expected the panics to be logged got: %v", logger.logs)
+	}
+	if err := b.Publish("a", &payload.Payload{}); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got: %v", err)
+	}
+	if !b.Unsubscribe(id) || b.Unsubscribe(id) {
+		t.Fatal("expected the subscription to be removed once")
+	}
+}
+
+func TestEventBusReplayAndTTL(t *testing.T) {
+	b := NewEventBusWithOpts(&Options{ReplaySize: 3, Logger: log.Default()})
+	defer b.Close()
+	old := &payload.Payload{FromPortID: "old", Timestamp: time.Now().Add(-time.Minute)}
+	if err := b.Publish("temp", old); err != nil {
+		t.Fatal(err)
+	}
+	published := &payload.Payload{FromPortID: "new"}
+	_ = b.Publish("temp", published)
+	time.Sleep(20 * time.Millisecond)
+	if latest, ok := b.Latest("temp"); !ok || latest.FromPortID != "new" || latest.Timestamp.IsZero() {
+		t.Fatalf("expected the latest payload with the publish time got: %+v", latest)
+	}
+	if !published.Timestamp.IsZero() {
+		t.Fatal("expected the publish time to not be set on the caller's payload")
+	}
 
+	type result struct {
+		port string
+		err  error
+	}
+	results := make(chan result, 10)
+	callback := func(topic string, data *payload.Payload, err error) {
+		results <- result{data.FromPortID, err}
+	}
+	b.SubscribeWithOpts("temp", &SubscribeOpts{Replay: bus.Replay{Last: 2}, TTL: time.Second}, callback)
+	if len(results) != 2 {
+		t.Fatalf("expected the replay to be delivered before subscribing returns got: %d", len(results))
+	}
+	first, second := <-results, <-results
+	if first.port != "old" || !errors.Is(first.err, payload.ErrExpired) {
+		t.Fatalf("expected the old payload to be flagged as expired got: %+v", first)
+	}
+	if second.port != "new" || second.err != nil {
+		t.Fatalf("expected the new payload without an err got: %+v", second)
+	}
+
+	b.SubscribeWithOpts("temp", &SubscribeOpts{Replay: bus.Replay{Last: 2}, TTL: time.Second, DropExpired: true}, callback)
+	if r := <-results; r.port != "new" {
+		t.Fatalf("expected the expired payload to be dropped got: %+v", r)
+	}
+}
+
+func TestEventBusReplayOrder(t *testing.T) {
+	b := NewEventBusWithOpts(&Options{ReplaySize: 2})
+	defer b.Close()
+	_ = b.Publish("temp", &payload.Payload{FromPortID: "1"})
+	_ = b.Publish("temp", &payload.Payload{FromPortID: "2"})
+	time.Sleep(20 * time.Millisecond)
+
+	// a payload published during the replay is delivered after the replayed payloads
+	got := make(chan string, 10)
+	b.SubscribeWithReplay("temp", bus.Replay{Last: 2}, func(topic string, data *payload.Payload, err error) {
+		got <- data.FromPortID
+		if data.FromPortID == "1" {
+			_ = b.Publish("temp", &payload.Payload{FromPortID: "3"})
+			time.Sleep(20 * time.Millisecond) // the live payload is routed while the replay is running
+		}
+	})
+	for _, want := range []string{"1", "2", "3"} {
+		select {
+		case port := <-got:
+			if port != want {
+				t.Fatalf("expected payload: %s got: %s", want, port)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for payload: %s", want)
+		}
+	}
+}
+
+func TestEventBusContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := NewEventBusWithOpts(&Options{Context: ctx})
+
+	subCtx, unsubscribe := context.WithCancel(context.Background())
+	id := b.SubscribeWithOpts("a", &SubscribeOpts{Context: subCtx}, func(topic string, data *payload.Payload, err error) {})
+	unsubscribe()
+	time.Sleep(10 * time.Millisecond)
+	if b.Unsubscribe(id) {
+		t.Fatal("expected the subscription to be removed when its context is done")
+	}
+
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	if err := b.Publish("a", &payload.Payload{}); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected the bus to be closed with its context got: %v", err)
+	}
+}
+
+func TestEventBusCloseFromCallback(t *testing.T) {
+	b := NewEventBus()
+	closed := make(chan error, 1)
+	b.Subscribe("a", func(topic string, data *payload.Payload, err error) {
+		closed <- b.Close()
+	})
+	if err := b.Publish("a", &payload.Payload{}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a close from a callback not to deadlock")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := b.Drain(ctx); err != nil {
+		t.Fatal(err)
+	}
 }
@@ -37,6 +37,15 @@ func Get[T any](t *T) T {
 	return *t
 }
 
+// Copy returns a new pointer holding the same value, or nil if the passed pointer is nil.
+func Copy[T any](t *T) *T {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	return &v
+}
+
 func ToAny[O any](value interface{}) (okValue O, pointer *O, ok bool) {
 	var output O
 
@@ -12,11 +12,16 @@ type PG interface {
 	NewTable(tableName string, schema string) error
 	Insert(tableName string, data map[string]interface{}) error
 	InsertBulk(tableName string, data []map[string]interface{}) error
+	Upsert(tableName, conflictColumn string, data map[string]interface{}) error
+	UpsertBulk(tableName, conflictColumn string, data []map[string]interface{}) error
+	Exec(query string, args ...interface{}) error
 	Select(query string) []map[string]interface{}
+	Query(query string, args ...interface{}) ([]map[string]interface{}, error)
 	Delete(query string) error
 	ShowAllTables() ([]string, error)
 	ShowAllDBs() ([]string, error)
 	Print(data []map[string]interface{})
+	Close()
 }
 
 // PGImpl implements the PG interface using pgx.
@@ -63,21 +68,62 @@ func (db *PGImpl) Insert(tableName string, data map[string]interface{}) error {
 	return err
 }
 
+// Upsert inserts data into the specified table, or updates the existing row if the conflictColumn already exists.
+func (db *PGImpl) Upsert(tableName, conflictColumn string, data map[string]interface{}) error {
+	if len(data) == 0 {
+		return fmt.Errorf("upsert %s: data can not be empty", tableName)
+	}
+	var columns, values, updates []string
+	args := []interface{}{}
+	i := 1
+	for k, v := range data {
+		columns = append(columns, k)
+		values = append(values, fmt.Sprintf("$%d", i))
+		if k != conflictColumn {
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", k, k))
+		}
+		args = append(args, v)
+		i++
+	}
+	onConflict := "DO NOTHING"
+	if len(updates) > 0 {
+		onConflict = fmt.Sprintf("DO UPDATE SET %s", strings.Join(updates, ","))
+	}
+	sqlStatement := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) %s;",
+		tableName, strings.Join(columns, ","), strings.Join(values, ","), conflictColumn, onConflict)
+	_, err := db.pool.Exec(context.Background(), sqlStatement, args...)
+	return err
+}
+
+// Exec performs a raw SQL statement with optional args, eg; Exec("DELETE FROM objects WHERE uuid = $1", "abc")
+func (db *PGImpl) Exec(query string, args ...interface{}) error {
+	_, err := db.pool.Exec(context.Background(), query, args...)
+	return err
+}
+
 // Select performs a raw SQL query and returns the results.
 func (db *PGImpl) Select(query string) []map[string]interface{} {
-	rows, err := db.pool.Query(context.Background(), query)
+	results, err := db.Query(query)
 	if err != nil {
 		fmt.Println("postgres: ", err)
 		return nil
 	}
+	return results
+}
+
+// Query performs a raw SQL query with optional args, eg; Query("SELECT * FROM objects WHERE uuid = $1", "abc")
+func (db *PGImpl) Query(query string, args ...interface{}) ([]map[string]interface{}, error) {
+	rows, err := db.pool.Query(context.Background(), query, args...)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 	columns := rows.FieldDescriptions()
 	var results []map[string]interface{}
 	for rows.Next() {
 		values, err := rows.Values()
 		if err != nil {
-			fmt.Println("postgres: ", err)
-			return nil
+			return nil, err
 		}
 		result := make(map[string]interface{})
 		for i, v := range values {
@@ -85,7 +131,7 @@ func (db *PGImpl) Select(query string) []map[string]interface{} {
 		}
 		results = append(results, result)
 	}
-	return results
+	return results, rows.Err()
 }
 
 // Delete performs a raw SQL delete operation.
@@ -141,7 +187,26 @@ func (db *PGImpl) InsertBulk(tableName string, data []map[string]interface{}) er
 	return nil
 }
 
+func (db *PGImpl) UpsertBulk(tableName, conflictColumn string, data []map[string]interface{}) error {
+	for _, record := range data {
+		if err := db.Upsert(tableName, conflictColumn, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes all the connections in the pool
+func (db *PGImpl) Close() {
+	db.pool.Close()
+}
+
 func (db *PGImpl) Print(data []map[string]interface{}) {
+	Print(data)
+}
+
+// Print prints the rows returned from a Select()
+func Print(data []map[string]interface{}) {
 	if len(data) == 0 {
 		return
 	}
@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"fmt"
+	"github.com/NubeIO/rxlib/libs/pglib"
+	"sort"
+	"sync"
+)
+
+type memoryStorage struct {
+	mutex  sync.RWMutex
+	tables map[string]map[string]Row
+}
+
+// NewMemory is a map backed storage that only lives in memory; used for edge devices without a db and for unit tests.
+// It is pure go so unlike NewSQLite() it works in a build with CGO_ENABLED=0
+func NewMemory() (Storage, error) {
+	return &memoryStorage{tables: make(map[string]map[string]Row)}, nil
+}
+
+func (s *memoryStorage) Name() Type {
+	return Memory
+}
+
+// DB there is no sql for the memory backend, only the table names can be listed
+func (s *memoryStorage) DB() pglib.PG {
+	return &memoryDB{storage: s}
+}
+
+func (s *memoryStorage) SaveRows(table string, rows []*Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	t := s.tables[table]
+	if t == nil {
+		t = make(map[string]Row)
+		s.tables[table] = t
+	}
+	for _, row := range rows {
+		t[row.Key] = *row
+	}
+	return nil
+}
+
+func (s *memoryStorage) DeleteRows(table string, keys []string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, key := range keys {
+		delete(s.tables[table], key)
+	}
+	return nil
+}
+
+func (s *memoryStorage) GetRow(table, key string) (*Row, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	row, ok := s.tables[table][key]
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+func (s *memoryStorage) Rows(table string) ([]*Row, error) {
+	return s.query(table, func(row Row) bool { return true }), nil
+}
+
+func (s *memoryStorage) RowsByGroup(table, group string) ([]*Row, error) {
+	return s.query(table, func(row Row) bool { return row.Group == group }), nil
+}
+
+// query returns copies of the matched rows ordered by time, the same as the sql backends
+func (s *memoryStorage) query(table string, match func(row Row) bool) []*Row {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	var out []*Row
+	for _, row := range s.tables[table] {
+		if match(row) {
+			row := row
+			out = append(out, &row)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Time.Equal(out[j].Time) {
+			return out[i].Key < out[j].Key
+		}
+		return out[i].Time.Before(out[j].Time)
+	})
+	return out
+}
+
+func (s *memoryStorage) Close() error {
+	return nil
+}
+
+func (s *memoryStorage) tableNames() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	names := make([]string, 0, len(s.tables))
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// memoryDB implements pglib.PG for the memory backend, the sql calls return an error
+type memoryDB struct {
+	storage *memoryStorage
+}
+
+func errNoSQL(call string) error {
+	return fmt.Errorf("storage: %s is not supported by the memory backend, it has no sql", call)
+}
+
+func (db *memoryDB) NewTable(tableName string, schema string) error {
+	return errNoSQL("NewTable")
+}
+
+func (db *memoryDB) Insert(tableName string, data map[string]interface{}) error {
+	return errNoSQL("Insert")
+}
+
+func (db *memoryDB) InsertBulk(tableName string, data []map[string]interface{}) error {
+	return errNoSQL("InsertBulk")
+}
+
+func (db *memoryDB) Upsert(tableName, conflictColumn string, data map[string]interface{}) error {
+	return errNoSQL("Upsert")
+}
+
+func (db *memoryDB) UpsertBulk(tableName, conflictColumn string, data []map[string]interface{}) error {
+	return errNoSQL("UpsertBulk")
+}
+
+func (db *memoryDB) Exec(query string, args ...interface{}) error {
+	return errNoSQL("Exec")
+}
+
+func (db *memoryDB) Select(query string) []map[string]interface{} {
+	fmt.Println("memory: ", errNoSQL("Select"))
+	return nil
+}
+
+func (db *memoryDB) Query(query string, args ...interface{}) ([]map[string]interface{}, error) {
+	return nil, errNoSQL("Query")
+}
+
+func (db *memoryDB) Delete(query string) error {
+	return errNoSQL("Delete")
+}
+
+func (db *memoryDB) ShowAllTables() ([]string, error) {
+	return db.storage.tableNames(), nil
+}
+
+func (db *memoryDB) ShowAllDBs() ([]string, error) {
+	return []string{string(Memory)}, nil
+}
+
+func (db *memoryDB) Print(data []map[string]interface{}) {
+	pglib.Print(data)
+}
+
+func (db *memoryDB) Close() {}
@@ -0,0 +1,314 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/NubeIO/rxlib/libs/pglib"
+	_ "github.com/mattn/go-sqlite3"
+	"strings"
+	"sync"
+	"time"
+)
+
+const sqliteRowsSchema = `
+	key       TEXT PRIMARY KEY,
+	group_key TEXT,
+	value     TEXT,
+	time      TIMESTAMP NOT NULL`
+
+type sqlStorage struct {
+	name   Type
+	db     *sqliteDB
+	mutex  sync.Mutex
+	tables map[string]bool
+}
+
+// NewSQLite opens or creates a sqlite db file, it needs a build with CGO_ENABLED=1; eg NewSQLite("/data/ros.db")
+func NewSQLite(path string) (Storage, error) {
+	if path == "" {
+		return nil, fmt.Errorf("storage: sqlite file path can not be empty")
+	}
+	db, err := openSQLite(path)
+	if err != nil {
+		return nil, err
+	}
+	return newSQLStorage(SQLite, db), nil
+}
+
+func newSQLStorage(name Type, db *sql.DB) *sqlStorage {
+	return &sqlStorage{
+		name:   name,
+		db:     &sqliteDB{db: db},
+		tables: make(map[string]bool),
+	}
+}
+
+func openSQLite(dsn string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening sqlite: %w", err)
+	}
+	// sqlite only allows one writer, and an in-memory db only lives as long as its connection
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("opening sqlite: %w", err)
+	}
+	return db, nil
+}
+
+func (s *sqlStorage) Name() Type {
+	return s.name
+}
+
+func (s *sqlStorage) DB() pglib.PG {
+	return s.db
+}
+
+func (s *sqlStorage) table(name string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.tables[name] {
+		return nil
+	}
+	if err := s.db.NewTable(name, sqliteRowsSchema); err != nil {
+		return fmt.Errorf("create table %s: %v", name, err)
+	}
+	s.tables[name] = true
+	return nil
+}
+
+func (s *sqlStorage) SaveRows(table string, rows []*Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if err := s.table(table); err != nil {
+		return err
+	}
+	tx, err := s.db.db.Begin()
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (key, group_key, value, time) VALUES (?, ?, ?, ?)
+		ON CONFLICT (key) DO UPDATE SET group_key = excluded.group_key, value = excluded.value, time = excluded.time;`, table)
+	for _, row := range rows {
+		if _, err := tx.Exec(query, row.Key, row.Group, row.Value, row.Time.UTC()); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStorage) DeleteRows(table string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := s.table(table); err != nil {
+		return err
+	}
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = key
+	}
+	query := fmt.Sprintf("DELETE FROM %s WHERE key IN (%s);", table, placeholders(len(keys)))
+	return s.db.Exec(query, args...)
+}
+
+func (s *sqlStorage) GetRow(table, key string) (*Row, error) {
+	rows, err := s.query(table, "WHERE key = ?", key)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return rows[0], nil
+}
+
+func (s *sqlStorage) Rows(table string) ([]*Row, error) {
+	return s.query(table, "")
+}
+
+func (s *sqlStorage) RowsByGroup(table, group string) ([]*Row, error) {
+	return s.query(table, "WHERE group_key = ?", group)
+}
+
+func (s *sqlStorage) query(table, where string, args ...interface{}) ([]*Row, error) {
+	if err := s.table(table); err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf("SELECT key, group_key, value, time FROM %s %s ORDER BY time;", table, where)
+	rows, err := s.db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*Row
+	for rows.Next() {
+		row := &Row{}
+		var group, value sql.NullString
+		if err := rows.Scan(&row.Key, &group, &value, &row.Time); err != nil {
+			return nil, err
+		}
+		row.Group = group.String
+		row.Value = value.String
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func (s *sqlStorage) Close() error {
+	return s.db.db.Close()
+}
+
+// sqliteDB implements pglib.PG so DB() works the same no matter the backend
+type sqliteDB struct {
+	db *sql.DB
+}
+
+func (db *sqliteDB) NewTable(tableName string, schema string) error {
+	return db.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s);", tableName, schema))
+}
+
+func (db *sqliteDB) Insert(tableName string, data map[string]interface{}) error {
+	columns, args := splitColumns(data)
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", tableName, strings.Join(columns, ","), placeholders(len(columns)))
+	return db.Exec(query, args...)
+}
+
+func (db *sqliteDB) InsertBulk(tableName string, data []map[string]interface{}) error {
+	for _, record := range data {
+		if err := db.Insert(tableName, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *sqliteDB) Upsert(tableName, conflictColumn string, data map[string]interface{}) error {
+	if len(data) == 0 {
+		return fmt.Errorf("upsert %s: data can not be empty", tableName)
+	}
+	columns, args := splitColumns(data)
+	var updates []string
+	for _, column := range columns {
+		if column != conflictColumn {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", column, column))
+		}
+	}
+	onConflict := "DO NOTHING"
+	if len(updates) > 0 {
+		onConflict = fmt.Sprintf("DO UPDATE SET %s", strings.Join(updates, ","))
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) %s;",
+		tableName, strings.Join(columns, ","), placeholders(len(columns)), conflictColumn, onConflict)
+	return db.Exec(query, args...)
+}
+
+func (db *sqliteDB) UpsertBulk(tableName, conflictColumn string, data []map[string]interface{}) error {
+	for _, record := range data {
+		if err := db.Upsert(tableName, conflictColumn, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *sqliteDB) Exec(query string, args ...interface{}) error {
+	_, err := db.db.Exec(query, args...)
+	return err
+}
+
+func (db *sqliteDB) Select(query string) []map[string]interface{} {
+	results, err := db.Query(query)
+	if err != nil {
+		fmt.Println("sqlite: ", err)
+		return nil
+	}
+	return results
+}
+
+func (db *sqliteDB) Query(query string, args ...interface{}) ([]map[string]interface{}, error) {
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var results []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		result := make(map[string]interface{})
+		for i, v := range values {
+			if b, ok := v.([]byte); ok {
+				v = string(b)
+			}
+			result[columns[i]] = v
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
+func (db *sqliteDB) Delete(query string) error {
+	return db.Exec(query)
+}
+
+func (db *sqliteDB) ShowAllTables() ([]string, error) {
+	return db.names("SELECT name FROM sqlite_master WHERE type = 'table' ORDER BY name;", "name")
+}
+
+func (db *sqliteDB) ShowAllDBs() ([]string, error) {
+	return db.names("PRAGMA database_list;", "name")
+}
+
+func (db *sqliteDB) names(query, column string) ([]string, error) {
+	results, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, result := range results {
+		if name, ok := result[column].(string); ok {
+			out = append(out, name)
+		}
+	}
+	return out, nil
+}
+
+func (db *sqliteDB) Print(data []map[string]interface{}) {
+	pglib.Print(data)
+}
+
+func (db *sqliteDB) Close() {
+	db.db.Close()
+}
+
+func splitColumns(data map[string]interface{}) ([]string, []interface{}) {
+	var columns []string
+	var args []interface{}
+	for k, v := range data {
+		columns = append(columns, k)
+		if t, ok := v.(time.Time); ok {
+			v = t.UTC()
+		}
+		args = append(args, v)
+	}
+	return columns, args
+}
+
+func placeholders(count int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", count), ",")
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testRows(t *testing.T, s Storage) {
+	now := time.Now()
+	err := s.SaveRows("objects", []*Row{
+		{Key: "a", Group: "root", Value: `{"name":"a"}`, Time: now},
+		{Key: "b", Group: "root", Value: `{"name":"b"}`, Time: now.Add(time.Second)},
+		{Key: "c", Group: "a", Value: `{"name":"c"}`, Time: now.Add(2 * time.Second)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// update an existing key
+	if err := s.SaveRows("objects", []*Row{{Key: "a", Group: "root", Value: `{"name":"aa"}`, Time: now}}); err != nil {
+		t.Fatal(err)
+	}
+	row, err := s.GetRow("objects", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row == nil || row.Value != `{"name":"aa"}` {
+		t.Fatalf("unexpected row: %+v", row)
+	}
+	rows, err := s.RowsByGroup("objects", "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows got: %d", len(rows))
+	}
+	if err := s.DeleteRows("objects", []string{"a", "c"}); err != nil {
+		t.Fatal(err)
+	}
+	rows, err = s.Rows("objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Key != "b" {
+		t.Fatalf("expected only row b to be left got: %+v", rows)
+	}
+	row, err = s.GetRow("objects", "a")
+	if err != nil || row != nil {
+		t.Fatalf("expected row a to be deleted got: %+v err: %v", row, err)
+	}
+	tables, err := s.DB().ShowAllTables()
+	if err != nil || len(tables) != 1 {
+		t.Fatalf("expected 1 table got: %v err: %v", tables, err)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	s, err := New(&Options{Type: Memory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	testRows(t, s)
+
+	// the rows are copies, changing them does not change the stored rows
+	row, _ := s.GetRow("objects", "b")
+	row.Value = "changed"
+	if row, _ = s.GetRow("objects", "b"); row.Value != `{"name":"b"}` {
+		t.Fatalf("expected the stored row to be unchanged got: %+v", row)
+	}
+}
+
+func TestSQLite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ros.db")
+	s, err := New(&Options{Type: SQLite, Connection: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testRows(t, s)
+	s.Close()
+
+	// reopen the file and check the data is still there
+	s, err = NewSQLite(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	rows, err := s.Rows("objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row after reopening got: %d", len(rows))
+	}
+}
@@ -0,0 +1,361 @@
+package rxlib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// LifecyclePhase is the step of the object lifecycle an error happened in
+type LifecyclePhase string
+
+const (
+	LifecycleInit  LifecyclePhase = "init"
+	LifecycleStart LifecyclePhase = "start"
+	LifecycleStop  LifecyclePhase = "stop"
+)
+
+// LifecycleError is the error of an object Init(), Start() or stop hook
+type LifecycleError struct {
+	ObjectUUID string
+	Phase      LifecyclePhase
+	Err        error
+}
+
+func (e *LifecycleError) Error() string {
+	return fmt.Sprintf("%s object: %s err: %v", e.Phase, e.ObjectUUID, e.Err)
+}
+
+func (e *LifecycleError) Unwrap() error {
+	return e.Err
+}
+
+// ObjectStopper can be implemented by an object to release its goroutines and sockets on shutdown, if not implemented Delete() is called
+type ObjectStopper interface {
+	Stop(ctx context.Context) error
+}
+
+// LifecycleManager calls Init(), Start() and the stop hooks of the objects in order
+type LifecycleManager interface {
+	// Init calls Init() on the objects that are not started, a parent is initialised before its children
+	Init() []*LifecycleError
+	// Start inits and starts the objects that are not started, in the order of their parents and connections; so Start can be called again after a deploy
+	Start() []*LifecycleError
+	// Stop calls the stop hooks in the reverse start order, the objects not stopped before the ctx is done return the ctx err
+	Stop(ctx context.Context) []*LifecycleError
+	// Order returns the object uuids in the start order
+	Order() ([]string, error)
+	// Started returns true if the object has been started and not stopped
+	Started(objectUUID string) bool
+}
+
+type lifecycleManager struct {
+	runtime     *RuntimeImpl
+	mutex       sync.Mutex // one Init, Start or Stop at a time
+	initialised map[string]bool
+	started     []string // in the order they were started
+	isStarted   map[string]bool
+}
+
+func newLifecycleManager(r *RuntimeImpl) *lifecycleManager {
+	return &lifecycleManager{
+		runtime:     r,
+		initialised: make(map[string]bool),
+		isStarted:   make(map[string]bool),
+	}
+}
+
+func (inst *RuntimeImpl) Lifecycle() LifecycleManager {
+	return inst.lifecycle
+}
+
+// order sorts the objects so a parent is before its children, and if withConnections a publisher is before its subscribers
+func (l *lifecycleManager) order(withConnections bool) ([]Object, error) {
+	objects := l.runtime.Get()
+	uuids := make([]string, 0, len(objects))
+	byUUID := make(map[string]Object, len(objects))
+	for _, object := range objects {
+		uuids = append(uuids, object.GetUUID())
+		byUUID[object.GetUUID()] = object
+	}
+	edges := make(map[string]map[string]struct{})
+	addEdge := func(source, target string) {
+		if source == target || byUUID[source] == nil || byUUID[target] == nil {
+			return
+		}
+		if edges[source] == nil {
+			edges[source] = make(map[string]struct{})
+		}
+		edges[source][target] = struct{}{}
+	}
+	for _, object := range objects {
+		addEdge(object.GetParentUUID(), object.GetUUID())
+		if !withConnections {
+			continue
+		}
+		for _, connection := range object.GetConnections() {
+			if !connection.GetDisable() {
+				addEdge(connection.GetSourceUUID(), connection.GetTargetUUID())
+			}
+		}
+	}
+	order, _, err := sortFlow(uuids, edges)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Object, 0, len(order))
+	for _, uuid := range order {
+		out = append(out, byUUID[uuid])
+	}
+	return out, nil
+}
+
+func (l *lifecycleManager) Order() ([]string, error) {
+	objects, err := l.order(true)
+	if err != nil {
+		return nil, err
+	}
+	return objectUUIDs(objects), nil
+}
+
+func objectUUIDs(objects []Object) []string {
+	out := make([]string, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.GetUUID())
+	}
+	return out
+}
+
+func (l *lifecycleManager) Started(objectUUID string) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.isStarted[objectUUID]
+}
+
+func (l *lifecycleManager) Init() []*LifecycleError {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.init(nil)
+}
+
+// init the objects in only, or all the objects if only is nil
+func (l *lifecycleManager) init(only map[string]bool) []*LifecycleError {
+	objects, err := l.order(false)
+	if err != nil {
+		return []*LifecycleError{{Phase: LifecycleInit, Err: err}}
+	}
+	var errs []*LifecycleError
+	failed := make(map[string]bool)
+	for _, object := range objects {
+		uuid := object.GetUUID()
+		if l.initialised[uuid] || l.isStarted[uuid] || (only != nil && !only[uuid]) {
+			continue
+		}
+		if failed[object.GetParentUUID()] {
+			failed[uuid] = true
+			errs = append(errs, l.fail(object, LifecycleInit, fmt.Errorf("parent: %s failed to init", object.GetParentUUID())))
+			continue
+		}
+		if err := lifecycleCall(object.Init); err != nil {
+			failed[uuid] = true
+			errs = append(errs, l.fail(object, LifecycleInit, err))
+			continue
+		}
+		l.initialised[uuid] = true
+	}
+	return errs
+}
+
+func (l *lifecycleManager) Start() []*LifecycleError {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.start(nil)
+}
+
+// start the objects in only, or all the objects if only is nil
+func (l *lifecycleManager) start(only map[string]bool) []*LifecycleError {
+	errs := l.init(only)
+	objects, err := l.order(true)
+	if err != nil {
+		// the connections loop back on themselves, the parents must still be started first
+		log.Printf("lifecycle: start order: %v", err)
+		if objects, err = l.order(false); err != nil {
+			return append(errs, &LifecycleError{Phase: LifecycleStart, Err: err})
+		}
+	}
+	for _, object := range objects {
+		uuid := object.GetUUID()
+		if l.isStarted[uuid] || !l.initialised[uuid] || (only != nil && !only[uuid]) {
+			continue
+		}
+		parent := object.GetParentUUID()
+		if l.runtime.GetByUUID(parent) != nil && !l.isStarted[parent] {
+			errs = append(errs, l.fail(object, LifecycleStart, fmt.Errorf("parent: %s is not started", parent)))
+			continue
+		}
+		if err := lifecycleCall(object.Start); err != nil {
+			errs = append(errs, l.fail(object, LifecycleStart, err))
+			continue
+		}
+		if object.IsNotLoaded() {
+			object.SetLoaded()
+		}
+		object.SetStatus(StatsLoaded)
+		l.runtime.NotifyStatus(uuid, StatsLoaded)
+		l.isStarted[uuid] = true
+		l.started = append(l.started, uuid)
+	}
+	return errs
+}
+
+func (l *lifecycleManager) Stop(ctx context.Context) []*LifecycleError {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.stop(ctx, nil)
+}
+
+// stop the objects in only, or all the objects if only is nil; only holds the objects so the objects already removed from the runtime can be stopped
+func (l *lifecycleManager) stop(ctx context.Context, only map[string]Object) []*LifecycleError {
+	var errs []*LifecycleError
+	var started []string
+	for i := len(l.started) - 1; i >= 0; i-- {
+		uuid := l.started[i]
+		object := l.runtime.GetByUUID(uuid)
+		if only != nil {
+			if object = only[uuid]; object == nil {
+				started = append([]string{uuid}, started...)
+				continue
+			}
+		}
+		delete(l.isStarted, uuid)
+		delete(l.initialised, uuid)
+		if object == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, l.fail(object, LifecycleStop, err))
+			continue
+		}
+		if err := stopObject(ctx, object); err != nil {
+			errs = append(errs, l.fail(object, LifecycleStop, err))
+			continue
+		}
+		object.SetStatus(StatsStopped)
+		l.runtime.NotifyStatus(uuid, StatsStopped)
+	}
+	l.started = started
+	return errs
+}
+
+// running returns true if the objects have been started, so the objects added by a deploy need to be started
+func (l *lifecycleManager) running() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return len(l.started) > 0
+}
+
+// startObjects inits and starts the objects, used to start the objects added by a deploy
+func (l *lifecycleManager) startObjects(uuids []string) []*LifecycleError {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.start(toSet(uuids))
+}
+
+// stopObjects calls the stop hooks of the objects that are started, it returns the objects that were started
+func (l *lifecycleManager) stopObjects(ctx context.Context, objects []Object) ([]Object, []*LifecycleError) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	var stopped []Object
+	only := make(map[string]Object)
+	for _, object := range objects {
+		uuid := object.GetUUID()
+		if l.isStarted[uuid] {
+			only[uuid] = object
+			stopped = append(stopped, object)
+		}
+		delete(l.initialised, uuid)
+	}
+	if len(only) == 0 {
+		return nil, nil
+	}
+	return stopped, l.stop(ctx, only)
+}
+
+func toSet(values []string) map[string]bool {
+	out := make(map[string]bool, len(values))
+	for _, v := range values {
+		out[v] = true
+	}
+	return out
+}
+
+// stopObject calls the stop hook in a goroutine, so an object that hangs can't block the shutdown past the ctx deadline
+func stopObject(ctx context.Context, object Object) error {
+	done := make(chan error, 1)
+	go func() {
+		if stopper, ok := object.(ObjectStopper); ok {
+			done <- lifecycleCall(func() error { return stopper.Stop(ctx) })
+			return
+		}
+		done <- lifecycleCall(object.Delete)
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			// a hook that only returned because the ctx is done has timed out
+			err = ctx.Err()
+		}
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// lifecycleCall recovers a panic in the object, so one object can't take down the runtime
+func lifecycleCall(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return f()
+}
+
+func (l *lifecycleManager) fail(object Object, phase LifecyclePhase, err error) *LifecycleError {
+	object.SetStatus(StatsError)
+	object.SetError(string(phase), err)
+	l.runtime.NotifyStatus(object.GetUUID(), StatsError)
+	return &LifecycleError{ObjectUUID: object.GetUUID(), Phase: phase, Err: err}
+}
+
+// Close stops the flow executor and the objects in the reverse start order, then the mailboxes so the stop hooks can still use them,
+// then flushes the persisted port values and stops the db sync loops
+func (inst *RuntimeImpl) Close(ctx context.Context) error {
+	var errs []error
+	if inst.flow != nil {
+		inst.flow.Stop()
+	}
+	if inst.overrides != nil {
+		inst.overrides.Stop()
+	}
+	if inst.lifecycle != nil {
+		for _, err := range inst.lifecycle.Stop(ctx) {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, inst.mailboxes.stop(ctx)...)
+	if inst.persistence != nil {
+		inst.persistence.Stop()
+	}
+	if inst.dbSync != nil {
+		inst.dbSync.stop()
+	}
+	if inst.storage != nil && inst.ownsStorage {
+		if err := inst.storage.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close storage err: %v", err))
+		}
+	}
+	return errors.Join(errs...)
+}
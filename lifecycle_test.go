@@ -0,0 +1,207 @@
+package rxlib
+
+import (
+	"context"
+	"errors"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lifecycleObject only implements what the lifecycle manager needs
+type lifecycleObject struct {
+	testObject
+	connections []*runtime.Connection
+	calls       *[]string
+	mutex       *sync.Mutex
+	loaded      bool
+	status      ObjectStatus
+	errs        map[string]error
+	initErr     error
+	startErr    error
+}
+
+func (o *lifecycleObject) GetConnections() []*runtime.Connection { return o.connections }
+func (o *lifecycleObject) SetStatus(status ObjectStatus)         { o.status = status }
+func (o *lifecycleObject) SetError(key string, err error)        { o.errs[key] = err }
+func (o *lifecycleObject) IsNotLoaded() bool                     { return !o.loaded }
+func (o *lifecycleObject) SetLoaded()                            { o.loaded = true }
+func (o *lifecycleObject) Init() error                           { o.call("init"); return o.initErr }
+func (o *lifecycleObject) Start() error                          { o.call("start"); return o.startErr }
+func (o *lifecycleObject) Delete() error                         { o.call("delete"); return nil }
+func (o *lifecycleObject) call(name string) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	*o.calls = append(*o.calls, name+":"+o.uuid)
+}
+
+// hangingObject doesn't return from its stop hook until the ctx is done
+type hangingObject struct {
+	*lifecycleObject
+}
+
+func (o *hangingObject) Stop(ctx context.Context) error {
+	o.call("stop")
+	<-ctx.Done()
+	return nil
+}
+
+// callsMutex guards the calls, the stop hooks run in a goroutine
+var callsMutex sync.Mutex
+
+func newLifecycleObjects(parents map[string]string, uuids ...string) (map[string]*lifecycleObject, *[]string) {
+	calls := &[]string{}
+	mutex := &callsMutex
+	out := make(map[string]*lifecycleObject)
+	for _, uuid := range uuids {
+		out[uuid] = &lifecycleObject{testObject: testObject{uuid: uuid, parent: parents[uuid]}, calls: calls, mutex: mutex, errs: make(map[string]error)}
+	}
+	return out, calls
+}
+
+func equalCalls(t *testing.T, calls *[]string, expected ...string) {
+	t.Helper()
+	callsMutex.Lock()
+	defer callsMutex.Unlock()
+	if len(*calls) != len(expected) {
+		t.Fatalf("expected calls %v got %v", expected, *calls)
+	}
+	for i := range expected {
+		if (*calls)[i] != expected[i] {
+			t.Fatalf("expected calls %v got %v", expected, *calls)
+		}
+	}
+	*calls = nil
+}
+
+func TestLifecycleOrder(t *testing.T) {
+	// the child is added before its parent, and the logic object b publishes to a
+	o, calls := newLifecycleObjects(map[string]string{"device": "network"}, "a", "device", "network", "b")
+	publisher, subscriber := NewConnection("b", "out", "a", "in")
+	o["b"].connections = append(o["b"].connections, publisher)
+	o["a"].connections = append(o["a"].connections, subscriber)
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{o["a"], o["device"], o["network"], o["b"]})
+	r.lifecycle = newLifecycleManager(r)
+
+	if errs := r.Lifecycle().Start(); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	equalCalls(t, calls,
+		"init:a", "init:network", "init:b", "init:device",
+		"start:network", "start:b", "start:device", "start:a")
+	if o["device"].status != StatsLoaded || !o["device"].loaded || !r.Lifecycle().Started("device") {
+		t.Fatal("expected device to be loaded")
+	}
+
+	// a second start only starts the new objects
+	added := &lifecycleObject{testObject: testObject{uuid: "point", parent: "device"}, calls: calls, mutex: o["a"].mutex, errs: make(map[string]error)}
+	r.AddObject(added)
+	if errs := r.Lifecycle().Start(); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	equalCalls(t, calls, "init:point", "start:point")
+
+	if errs := r.Lifecycle().Stop(context.Background()); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	equalCalls(t, calls, "delete:point", "delete:a", "delete:device", "delete:b", "delete:network")
+	if o["network"].status != StatsStopped || r.Lifecycle().Started("network") {
+		t.Fatal("expected network to be stopped")
+	}
+}
+
+func TestLifecycleErrors(t *testing.T) {
+	o, calls := newLifecycleObjects(map[string]string{"device": "network", "point": "device"}, "network", "device", "point", "logic")
+	o["network"].initErr = errors.New("port in use")
+	o["logic"].startErr = errors.New("bad setting")
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{o["network"], o["device"], o["point"], o["logic"]})
+	r.lifecycle = newLifecycleManager(r)
+
+	errs := r.Lifecycle().Start()
+	if len(errs) != 4 {
+		t.Fatalf("expected 4 errs got %v", errs)
+	}
+	equalCalls(t, calls, "init:network", "init:logic", "start:logic")
+	if errs[0].ObjectUUID != "network" || errs[0].Phase != LifecycleInit || !errors.Is(errs[0], o["network"].initErr) {
+		t.Fatalf("unexpected err: %v", errs[0])
+	}
+	if errs[3].ObjectUUID != "logic" || errs[3].Phase != LifecycleStart {
+		t.Fatalf("unexpected err: %v", errs[3])
+	}
+	for _, uuid := range []string{"network", "device", "point", "logic"} {
+		if o[uuid].status != StatsError || len(o[uuid].errs) != 1 {
+			t.Fatalf("expected %s to have the error status", uuid)
+		}
+	}
+
+	// the init is retried on the next start
+	o["network"].initErr = nil
+	o["logic"].startErr = nil
+	if errs := r.Lifecycle().Start(); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	equalCalls(t, calls, "init:network", "init:device", "init:point", "start:network", "start:logic", "start:device", "start:point")
+}
+
+func TestLifecycleStopDeadline(t *testing.T) {
+	o, calls := newLifecycleObjects(nil, "first", "hanging", "last")
+	hanging := &hangingObject{o["hanging"]}
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{o["first"], hanging, o["last"]})
+	r.lifecycle = newLifecycleManager(r)
+	if errs := r.Lifecycle().Start(); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	equalCalls(t, calls, "init:first", "init:hanging", "init:last", "start:first", "start:hanging", "start:last")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	errs := r.Lifecycle().Stop(ctx)
+	if time.Since(start) > time.Second {
+		t.Fatal("expected the stop to give up at the deadline")
+	}
+	if len(errs) != 2 || errs[0].ObjectUUID != "hanging" || errs[1].ObjectUUID != "first" || !errors.Is(errs[1], context.DeadlineExceeded) {
+		t.Fatalf("unexpected errs: %v", errs)
+	}
+	equalCalls(t, calls, "delete:last", "stop:hanging")
+	if hanging.status != StatsError || o["first"].status != StatsError || o["last"].status != StatsStopped {
+		t.Fatalf("expected the timed out objects to be left in error: %s %s %s", hanging.status, o["first"].status, o["last"].status)
+	}
+}
+
+// mailboxStopObject posts to its mailbox from its stop hook
+type mailboxStopObject struct {
+	*lifecycleObject
+	runtime *RuntimeImpl
+	err     error
+}
+
+func (o *mailboxStopObject) Stop(ctx context.Context) error {
+	o.err = o.runtime.Mailbox(o.uuid).Call(ctx, func() { o.call("flush") })
+	return o.err
+}
+
+func TestCloseStopsObjectsBeforeMailboxes(t *testing.T) {
+	o, calls := newLifecycleObjects(nil, "a")
+	r := &RuntimeImpl{mailboxes: newMailboxes(&MailboxOpts{})}
+	object := &mailboxStopObject{lifecycleObject: o["a"], runtime: r}
+	r.AddObjects([]Object{object})
+	r.lifecycle = newLifecycleManager(r)
+	if errs := r.Lifecycle().Start(); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	_ = r.Mailbox("a")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := r.Close(ctx); err != nil || object.err != nil {
+		t.Fatalf("expected the stop hook to use its mailbox: %v %v", err, object.err)
+	}
+	equalCalls(t, calls, "init:a", "start:a", "flush:a")
+	if object.status != StatsStopped {
+		t.Fatalf("expected the object to be stopped got: %s", object.status)
+	}
+}
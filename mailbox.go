@@ -0,0 +1,403 @@
+package rxlib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/payload"
+	"log"
+	"sync"
+	"time"
+)
+
+const defaultMailboxSize = 1000
+
+// mailboxCommandTimeout is how long the runtime waits for a command or a Process() it sent to the mailbox of an object; a call sent from
+// inside the same mailbox can never run, so it fails after the timeout instead of blocking the mailbox forever
+const mailboxCommandTimeout = 30 * time.Second
+
+// ErrMailboxFull is returned when the mailbox has MailboxOpts.Size items waiting, the input updates to a port already waiting are never refused
+var ErrMailboxFull = errors.New("mailbox is full")
+
+// ErrMailboxStopped is returned when a message is sent to a mailbox that has been stopped
+var ErrMailboxStopped = errors.New("mailbox is stopped")
+
+type MailboxOpts struct {
+	Size int // how many commands, calls and new port updates can wait; default 1000
+}
+
+// MailboxStats are the counts of a mailbox since it was started
+type MailboxStats struct {
+	Pending   int    `json:"pending"`
+	Delivered uint64 `json:"delivered"`
+	Coalesced uint64 `json:"coalesced"` // input updates and Process() requests merged into one already waiting
+	Dropped   uint64 `json:"dropped"`   // refused as the mailbox was full
+}
+
+type mailboxKind int
+
+const (
+	mailboxInput mailboxKind = iota
+	mailboxProcess
+	mailboxCall
+)
+
+type mailboxItem struct {
+	kind    mailboxKind
+	portID  string
+	payload *payload.Payload
+	call    func()
+}
+
+// Mailbox runs everything sent to an object on one goroutine, in the order it was sent; so the object does not need its own locking.
+// An input update to a port that is already waiting replaces the waiting payload, and a Process() request that is already waiting is dropped
+type Mailbox struct {
+	object    Object
+	size      int
+	mutex     sync.Mutex
+	queue     []*mailboxItem
+	inputs    map[string]*mailboxItem // the waiting input updates, by port id
+	process   bool                    // a Process() is waiting
+	stopped   bool
+	stats     MailboxStats
+	wake      chan struct{}
+	done      chan struct{}
+	startOnce sync.Once
+}
+
+func NewMailbox(object Object, opts *MailboxOpts) *Mailbox {
+	if opts == nil {
+		opts = &MailboxOpts{}
+	}
+	if opts.Size <= 0 {
+		opts.Size = defaultMailboxSize
+	}
+	return &Mailbox{
+		object: object,
+		size:   opts.Size,
+		inputs: make(map[string]*mailboxItem),
+		wake:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+}
+
+// Start the mailbox goroutine, it is safe to call more than once
+func (m *Mailbox) Start() {
+	m.startOnce.Do(func() {
+		go m.run()
+	})
+}
+
+func (m *Mailbox) run() {
+	defer close(m.done)
+	for {
+		m.mutex.Lock()
+		for len(m.queue) == 0 && !m.stopped {
+			m.mutex.Unlock()
+			<-m.wake
+			m.mutex.Lock()
+		}
+		if len(m.queue) == 0 {
+			m.mutex.Unlock()
+			return
+		}
+		item := m.queue[0]
+		m.queue[0] = nil
+		m.queue = m.queue[1:]
+		switch item.kind {
+		case mailboxInput:
+			delete(m.inputs, item.portID)
+		case mailboxProcess:
+			m.process = false
+		}
+		m.stats.Delivered++
+		m.mutex.Unlock()
+		m.deliver(item)
+	}
+}
+
+func (m *Mailbox) deliver(item *mailboxItem) {
+	var err error
+	switch item.kind {
+	case mailboxInput:
+		err = lifecycleCall(func() error {
+			if errs := m.object.UpdateInputsValue(item.portID, item.payload); len(errs) > 0 {
+				return errors.Join(errs...)
+			}
+			m.object.OnInputUpdated(item.portID, item.payload)
+			return nil
+		})
+	case mailboxProcess:
+		err = lifecycleCall(m.object.Process)
+	case mailboxCall:
+		err = lifecycleCall(func() error {
+			item.call()
+			return nil
+		})
+	}
+	if err != nil {
+		log.Printf("mailbox object: %s err: %v", m.object.GetUUID(), err)
+		m.object.SetError("mailbox", err)
+	}
+}
+
+// send queues the item, an input update or Process() request already waiting is merged with it
+func (m *Mailbox) send(item *mailboxItem) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.stopped {
+		return ErrMailboxStopped
+	}
+	switch {
+	case item.kind == mailboxInput && m.inputs[item.portID] != nil:
+		m.inputs[item.portID].payload = item.payload
+		m.stats.Coalesced++
+		return nil
+	case item.kind == mailboxProcess && m.process:
+		m.stats.Coalesced++
+		return nil
+	case len(m.queue) >= m.size:
+		m.stats.Dropped++
+		return ErrMailboxFull
+	}
+	switch item.kind {
+	case mailboxInput:
+		m.inputs[item.portID] = item
+	case mailboxProcess:
+		m.process = true
+	}
+	m.queue = append(m.queue, item)
+	m.notify()
+	return nil
+}
+
+func (m *Mailbox) notify() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// UpdateInput queues UpdateInputsValue() and then OnInputUpdated() for the port, eg from an eventbus or mqtt callback; OnInputUpdated() is
+// not called if the update failed
+func (m *Mailbox) UpdateInput(portID string, p *payload.Payload) error {
+	return m.send(&mailboxItem{kind: mailboxInput, portID: portID, payload: p})
+}
+
+// Process queues a Process() call
+func (m *Mailbox) Process() error {
+	return m.send(&mailboxItem{kind: mailboxProcess})
+}
+
+// Post queues the func to run in the mailbox and does not wait for it
+func (m *Mailbox) Post(fn func()) error {
+	return m.send(&mailboxItem{kind: mailboxCall, call: fn})
+}
+
+// Call runs the func in the mailbox and waits for it, or until the ctx is done. It must not be called from inside the mailbox, that would wait forever
+func (m *Mailbox) Call(ctx context.Context, fn func()) error {
+	done := make(chan struct{})
+	err := m.Post(func() {
+		defer close(done)
+		fn()
+	})
+	if err != nil {
+		return err
+	}
+	select {
+	case <-done:
+		return nil
+	case <-m.done:
+		select {
+		case <-done:
+			return nil
+		default:
+			return ErrMailboxStopped
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Invoke runs the command on the object in the mailbox and waits for the response
+func (m *Mailbox) Invoke(ctx context.Context, command *ExtendedCommand) (*CommandResponse, error) {
+	var resp *CommandResponse
+	var invokeErr error
+	err := m.Call(ctx, func() {
+		resp, invokeErr = m.object.Invoke(command)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, invokeErr
+}
+
+// Command runs CommandObject() on the object in the mailbox and waits for the response
+func (m *Mailbox) Command(ctx context.Context, command *ExtendedCommand) (*CommandResponse, error) {
+	var resp *CommandResponse
+	err := m.Call(ctx, func() {
+		resp = m.object.CommandObject(command)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AfterFunc runs the func in the mailbox after the duration, it returns a func to cancel it
+func (m *Mailbox) AfterFunc(d time.Duration, fn func()) (cancel func() bool) {
+	t := time.AfterFunc(d, func() {
+		if err := m.Post(fn); err != nil && !errors.Is(err, ErrMailboxStopped) {
+			log.Printf("mailbox object: %s timer err: %v", m.object.GetUUID(), err)
+		}
+	})
+	return t.Stop
+}
+
+// Every runs the func in the mailbox on each interval until the returned func is called or the mailbox is stopped; a tick is skipped if the last one is still waiting
+func (m *Mailbox) Every(interval time.Duration, fn func()) (stop func()) {
+	ticker := time.NewTicker(interval)
+	quit := make(chan struct{})
+	var waiting sync.Mutex
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-quit:
+				return
+			case <-m.done:
+				return
+			case <-ticker.C:
+				if !waiting.TryLock() {
+					continue
+				}
+				if err := m.Post(func() { defer waiting.Unlock(); fn() }); err != nil {
+					waiting.Unlock()
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return func() { once.Do(func() { close(quit) }) }
+}
+
+// Stop refuses new messages and waits for the waiting ones to be delivered, or until the ctx is done
+func (m *Mailbox) Stop(ctx context.Context) error {
+	m.mutex.Lock()
+	m.stopped = true
+	m.notify()
+	m.mutex.Unlock()
+	m.Start() // so the waiting messages are delivered even if the mailbox was never started
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("mailbox object: %s stop err: %v", m.object.GetUUID(), ctx.Err())
+	}
+}
+
+func (m *Mailbox) Stats() MailboxStats {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	s := m.stats
+	s.Pending = len(m.queue)
+	return s
+}
+
+// mailboxes holds the mailbox of each object when RuntimeOpts.Mailbox is set
+type mailboxes struct {
+	opts   *MailboxOpts
+	mutex  sync.Mutex
+	byUUID map[string]*Mailbox
+	closed bool // stopped on Close(), no new mailbox is made after it
+}
+
+func newMailboxes(opts *MailboxOpts) *mailboxes {
+	if opts == nil {
+		return nil
+	}
+	return &mailboxes{opts: opts, byUUID: make(map[string]*Mailbox)}
+}
+
+// Mailbox returns the started mailbox of the object, or nil if the mailboxes are not enabled, the object is not found or the runtime is closed
+func (inst *RuntimeImpl) Mailbox(objectUUID string) *Mailbox {
+	if inst.mailboxes == nil {
+		return nil
+	}
+	object := inst.GetByUUID(objectUUID)
+	if object == nil {
+		return nil
+	}
+	inst.mailboxes.mutex.Lock()
+	defer inst.mailboxes.mutex.Unlock()
+	if inst.mailboxes.closed {
+		return nil
+	}
+	m, ok := inst.mailboxes.byUUID[objectUUID]
+	if !ok || m.object != object {
+		m = NewMailbox(object, inst.mailboxes.opts)
+		m.Start()
+		inst.mailboxes.byUUID[objectUUID] = m
+	}
+	return m
+}
+
+// remove stops the mailboxes of the objects, the messages still waiting are delivered in the background
+func (mb *mailboxes) remove(objects []Object) {
+	if mb == nil {
+		return
+	}
+	mb.mutex.Lock()
+	defer mb.mutex.Unlock()
+	for _, object := range objects {
+		if m, ok := mb.byUUID[object.GetUUID()]; ok && m.object == object {
+			delete(mb.byUUID, object.GetUUID())
+			go m.Stop(context.Background())
+		}
+	}
+}
+
+// stop all the mailboxes, used on Close()
+func (mb *mailboxes) stop(ctx context.Context) []error {
+	if mb == nil {
+		return nil
+	}
+	mb.mutex.Lock()
+	all := mb.byUUID
+	mb.byUUID = make(map[string]*Mailbox)
+	mb.closed = true
+	mb.mutex.Unlock()
+	var errs []error
+	for _, m := range all {
+		if err := m.Stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// invoke calls Invoke() on the object, in its mailbox when the runtime has them enabled
+func (inst *RuntimeImpl) invoke(object Object, command *ExtendedCommand) (*CommandResponse, error) {
+	m := inst.Mailbox(object.GetUUID())
+	if m == nil {
+		return object.Invoke(command)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), mailboxCommandTimeout)
+	defer cancel()
+	return m.Invoke(ctx, command)
+}
+
+// commandObject calls CommandObject() on the object, in its mailbox when the runtime has them enabled
+func (inst *RuntimeImpl) commandObject(object Object, command *ExtendedCommand) *CommandResponse {
+	m := inst.Mailbox(object.GetUUID())
+	if m == nil {
+		return object.CommandObject(command)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), mailboxCommandTimeout)
+	defer cancel()
+	resp, err := m.Command(ctx, command)
+	if err != nil {
+		return &CommandResponse{Error: fmt.Sprintf("object: %s command err: %v", object.GetUUID(), err)}
+	}
+	return resp
+}
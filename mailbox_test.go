@@ -0,0 +1,241 @@
+package rxlib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/payload"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// mailboxObject records the calls, and if any of them ran at the same time
+type mailboxObject struct {
+	testObject
+	mutex      sync.Mutex
+	calls      []string
+	active     int32
+	overlapped bool
+	err        error
+	updateErr  error
+	onUpdated  int32
+}
+
+func (o *mailboxObject) enter(call string) {
+	if atomic.AddInt32(&o.active, 1) > 1 {
+		o.overlapped = true
+	}
+	time.Sleep(time.Microsecond)
+	o.mutex.Lock()
+	o.calls = append(o.calls, call)
+	o.mutex.Unlock()
+	atomic.AddInt32(&o.active, -1)
+}
+
+func (o *mailboxObject) UpdateInputsValue(portID string, p *payload.Payload) []error {
+	o.enter(fmt.Sprintf("update %s %v", portID, p.FromObjectUUID))
+	if o.updateErr != nil {
+		return []error{o.updateErr}
+	}
+	return nil
+}
+func (o *mailboxObject) OnInputUpdated(portID string, p *payload.Payload) {
+	atomic.AddInt32(&o.onUpdated, 1)
+}
+func (o *mailboxObject) Process() error {
+	o.enter("process")
+	return nil
+}
+func (o *mailboxObject) Invoke(command *ExtendedCommand) (*CommandResponse, error) {
+	o.enter("invoke")
+	return &CommandResponse{Count: 1}, nil
+}
+func (o *mailboxObject) CommandObject(command *ExtendedCommand) *CommandResponse {
+	o.enter("command")
+	return &CommandResponse{Count: 2}
+}
+func (o *mailboxObject) SetError(key string, err error)        { o.err = err }
+func (o *mailboxObject) GetConnections() []*runtime.Connection { return nil }
+func (o *mailboxObject) GetStats() *runtime.ObjectStats        { return &runtime.ObjectStats{} }
+func (o *mailboxObject) SetStatus(status ObjectStatus)         {}
+
+// testPayload is a payload with the value kept in FromObjectUUID, so the delivered updates can be told apart
+func testPayload(value any) *payload.Payload {
+	return &payload.Payload{FromObjectUUID: fmt.Sprint(value)}
+}
+
+func (o *mailboxObject) getCalls() []string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return append([]string(nil), o.calls...)
+}
+
+func TestMailboxCoalesce(t *testing.T) {
+	object := &mailboxObject{testObject: testObject{uuid: "a"}}
+	m := NewMailbox(object, nil)
+	m.UpdateInput("in", testPayload(1))
+	m.Process()
+	m.UpdateInput("in", testPayload(2))
+	m.UpdateInput("b", testPayload(1))
+	m.Process()
+	m.Post(func() { object.enter("post") })
+	if s := m.Stats(); s.Pending != 4 || s.Coalesced != 2 {
+		t.Fatalf("unexpected stats: %+v", s)
+	}
+	if err := m.Stop(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"update in 2", "process", "update b 1", "post"}
+	if calls := object.getCalls(); !slices.Equal(calls, expected) {
+		t.Fatalf("expected calls %v got %v", expected, calls)
+	}
+	if err := m.Process(); !errors.Is(err, ErrMailboxStopped) {
+		t.Fatalf("expected the mailbox to be stopped, got: %v", err)
+	}
+	if err := m.Call(context.Background(), func() {}); !errors.Is(err, ErrMailboxStopped) {
+		t.Fatalf("expected the mailbox to be stopped, got: %v", err)
+	}
+}
+
+func TestMailboxInputError(t *testing.T) {
+	object := &mailboxObject{testObject: testObject{uuid: "a"}, updateErr: errors.New("bad value")}
+	m := NewMailbox(object, nil)
+	m.UpdateInput("in", testPayload(1))
+	if err := m.Stop(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if object.err == nil || atomic.LoadInt32(&object.onUpdated) != 0 {
+		t.Fatalf("expected the error to be set and OnInputUpdated() to be skipped got: %v %d", object.err, object.onUpdated)
+	}
+}
+
+func TestMailboxSerialize(t *testing.T) {
+	object := &mailboxObject{testObject: testObject{uuid: "a"}}
+	m := NewMailbox(object, &MailboxOpts{Size: 10000})
+	m.Start()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				m.UpdateInput(fmt.Sprintf("in-%d", j%3), testPayload(j))
+				m.Process()
+				m.Post(func() { object.enter("post") })
+			}
+		}(i)
+	}
+	wg.Wait()
+	resp, err := m.Invoke(context.Background(), &ExtendedCommand{})
+	if err != nil || resp.Count != 1 {
+		t.Fatalf("unexpected invoke: %v %v", resp, err)
+	}
+	m.Stop(context.Background())
+	if object.overlapped {
+		t.Fatal("expected the calls to run one at a time")
+	}
+	if s := m.Stats(); s.Delivered == 0 || s.Pending != 0 || s.Delivered+s.Coalesced != 10*50*3+1 {
+		t.Fatalf("unexpected stats: %+v", s)
+	}
+}
+
+func TestMailboxFull(t *testing.T) {
+	object := &mailboxObject{testObject: testObject{uuid: "a"}}
+	m := NewMailbox(object, &MailboxOpts{Size: 2})
+	m.UpdateInput("in", testPayload(1))
+	m.Post(func() {})
+	if err := m.Post(func() {}); !errors.Is(err, ErrMailboxFull) {
+		t.Fatalf("expected the mailbox to be full, got: %v", err)
+	}
+	if err := m.UpdateInput("in", testPayload(2)); err != nil {
+		t.Fatalf("expected an update to a waiting port to be merged, got: %v", err)
+	}
+	if s := m.Stats(); s.Dropped != 1 || s.Coalesced != 1 {
+		t.Fatalf("unexpected stats: %+v", s)
+	}
+
+	// a panic is recovered and set as the object error
+	m.Start()
+	m.Stop(context.Background())
+	m = NewMailbox(object, nil)
+	m.Start()
+	m.Post(func() { panic("boom") })
+	m.Stop(context.Background())
+	if object.err == nil {
+		t.Fatal("expected the panic to be set as the object error")
+	}
+}
+
+func TestMailboxTimers(t *testing.T) {
+	object := &mailboxObject{testObject: testObject{uuid: "a"}}
+	m := NewMailbox(object, nil)
+	m.Start()
+	defer m.Stop(context.Background())
+	fired := make(chan struct{}, 10)
+	m.AfterFunc(time.Millisecond, func() { fired <- struct{}{} })
+	cancel := m.AfterFunc(time.Hour, func() { fired <- struct{}{} })
+	cancel()
+	stop := m.Every(time.Millisecond, func() { fired <- struct{}{} })
+	for i := 0; i < 3; i++ {
+		select {
+		case <-fired:
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for the timers")
+		}
+	}
+	stop()
+}
+
+func TestRuntimeMailbox(t *testing.T) {
+	object := &mailboxObject{testObject: testObject{uuid: "a"}}
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{object})
+	if r.Mailbox("a") != nil {
+		t.Fatal("expected no mailbox when they are not enabled")
+	}
+	r.mailboxes = newMailboxes(&MailboxOpts{})
+	m := r.Mailbox("a")
+	if m == nil || r.Mailbox("a") != m || r.Mailbox("nope") != nil {
+		t.Fatal("expected one mailbox for the object")
+	}
+
+	// the flow executor processes the object in its mailbox
+	r.flow = newFlowExecutor(r, nil)
+	if errs := r.Flow().RunOnce(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if calls := object.getCalls(); !slices.Equal(calls, []string{"process"}) {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+
+	// the commands and Invoke() run in the mailbox
+	if resp := r.commandObject(object, &ExtendedCommand{}); resp.Count != 2 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp, err := r.invoke(object, nil); err != nil || resp.Count != 1 {
+		t.Fatalf("unexpected response: %+v %v", resp, err)
+	}
+	if stats := m.Stats(); stats.Delivered != 3 {
+		t.Fatalf("expected the calls to be delivered by the mailbox: %+v", stats)
+	}
+
+	if err := r.DeleteByUUID("a"); err != nil {
+		t.Fatal(err)
+	}
+	for start := time.Now(); m.Process() == nil; time.Sleep(time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatal("expected the mailbox of the deleted object to be stopped")
+		}
+	}
+
+	// no mailbox is made once the runtime is closed
+	r.AddObjects([]Object{&mailboxObject{testObject: testObject{uuid: "b"}}})
+	r.mailboxes.stop(context.Background())
+	if r.Mailbox("b") != nil {
+		t.Fatal("expected no mailbox after the mailboxes are stopped")
+	}
+}
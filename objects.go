@@ -2,89 +2,85 @@ package rxlib
 
 import (
 	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
 )
 
-func (inst *RuntimeImpl) AddObject(object Object) {
+func (inst *RuntimeImpl) AddObject(object Object) error {
 	inst.mutex.Lock()
-	defer inst.mutex.Unlock()
-	inst.objects = append(inst.objects, object)
+	if object != nil {
+		if err := inst.checkAdd([]Object{object}, append(slices.Clone(inst.objects), object)); err != nil {
+			inst.mutex.Unlock()
+			return err
+		}
+	}
+	added := inst.addObject(object)
+	inst.mutex.Unlock()
+	if added {
+		inst.restoreObjects([]Object{object})
+		inst.objectsChanged([]Object{object}, nil)
+	}
+	return nil
 }
 
 func (inst *RuntimeImpl) GetAllByID(objectID string) []Object {
-	inst.mutex.Lock()
-	defer inst.mutex.Unlock()
-	var out []Object
-	for _, obj := range inst.objects {
-		if obj.GetID() == objectID {
-			out = append(out, obj)
-		}
-	}
-	return out
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.objects(inst.index.byID, objectID)
 }
 
 func (inst *RuntimeImpl) GetByUUID(uuid string) Object {
-	inst.mutex.Lock()
-	defer inst.mutex.Unlock()
-	for _, object := range inst.objects {
-		if object.GetUUID() == uuid {
-			return object
-		}
-	}
-	return nil
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.byUUID[uuid]
 }
 
 func (inst *RuntimeImpl) GetAllByName(name string) []Object {
-	inst.mutex.Lock()
-	defer inst.mutex.Unlock()
-	var out []Object
-	for _, obj := range inst.objects {
-		if obj.GetName() == name {
-			out = append(out, obj)
-		}
-	}
-	return out
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.objects(inst.index.byName, name)
 }
 
 func (inst *RuntimeImpl) GetChildObjects(parentUUID string) []Object {
-	inst.mutex.Lock()
-	defer inst.mutex.Unlock()
-	var out []Object
-	for _, obj := range inst.objects {
-		if obj.GetParentUUID() == parentUUID {
-			out = append(out, obj)
-		}
-	}
-	return out
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.objects(inst.index.byParent, parentUUID)
 }
 
 func (inst *RuntimeImpl) GetFirstByID(objectID string) Object {
-	inst.mutex.Lock()
-	defer inst.mutex.Unlock()
-	for _, obj := range inst.objects {
-		if obj.GetID() == objectID {
-			return obj
-		}
-	}
-	return nil
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.first(inst.index.byID, objectID)
 }
 
 func (inst *RuntimeImpl) GetFirstByName(name string) Object {
-	inst.mutex.Lock()
-	defer inst.mutex.Unlock()
-	for _, obj := range inst.objects {
-		if obj.GetName() == name {
-			return obj
-		}
-	}
-	return nil
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.first(inst.index.byName, name)
+}
+
+// GetAllByCategory for example get all the "logic" objects
+func (inst *RuntimeImpl) GetAllByCategory(category string) []Object {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.objects(inst.index.byCategory, category)
+}
+
+// GetAllByWorkingGroup for example get all the objects of working group "rubix"
+func (inst *RuntimeImpl) GetAllByWorkingGroup(workingGroup string) []Object {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.objects(inst.index.byWorkingGroup, workingGroup)
+}
+
+func (inst *RuntimeImpl) GetAllByTag(tag string) []Object {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.index.objects(inst.index.byTag, tag)
 }
 
 func (inst *RuntimeImpl) GetAllObjectValues() []*ObjectValue {
-	inst.mutex.Lock()
-	defer inst.mutex.Unlock()
-	allObjects := inst.Get()
-	nodeValues := make([]*ObjectValue, len(allObjects))
-	for _, node := range allObjects {
+	nodeValues := make([]*ObjectValue, 0)
+	for _, node := range inst.Get() {
 		nv := node.GetAllPorts()
 		if nv == nil {
 			continue
@@ -123,6 +119,10 @@ func (inst *RuntimeImpl) GetObjectValues(objectUUID string) []*runtime.PortValue
 	if obj == nil {
 		return nil
 	}
+	return objectValues(obj)
+}
+
+func objectValues(obj Object) []*runtime.PortValue {
 	var out []*runtime.PortValue
 	inputs := obj.GetInputs()
 	for _, port := range inputs {
@@ -139,24 +139,18 @@ func (inst *RuntimeImpl) GetObjectsValues(parentUUID string) []*runtime.PortValu
 	var out []*runtime.PortValue
 	if parentUUID == "" {
 		for _, object := range inst.Get() {
-			out = append(out, inst.GetObjectValues(object.GetUUID())...)
+			out = append(out, objectValues(object)...)
 		}
 	} else {
 		for _, object := range inst.GetChildObjects(parentUUID) {
-			out = append(out, inst.GetObjectValues(object.GetUUID())...)
+			out = append(out, objectValues(object)...)
 		}
 	}
 	return out
 }
 
 func (inst *RuntimeImpl) GetObjectsRootConfig() []*runtime.ObjectConfig {
-	var objects []Object
-	for _, object := range inst.Get() {
-		if object.GetParentUUID() == "" {
-			objects = append(objects, object)
-		}
-	}
-	return inst.SerializeObjects(false, objects)
+	return inst.SerializeObjects(false, inst.GetChildObjects(""))
 }
 
 func (inst *RuntimeImpl) GetObjectsConfig() []*runtime.ObjectConfig {
@@ -192,7 +186,7 @@ func (inst *RuntimeImpl) GetObjectConfigByID(objectID string) *runtime.ObjectCon
 }
 
 func (inst *RuntimeImpl) GetTreeMapRoot() *runtime.ObjectsRootMap {
-	inst.tree.addObjects(inst.objects)
+	inst.tree.addObjects(inst.Get())
 	return inst.tree.GetTreeMapRoot()
 }
 
@@ -0,0 +1,444 @@
+package rxlib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	OverrideSet      = "set"
+	OverrideReleased = "released"
+	OverrideExpired  = "expired" // released by its timer
+)
+
+// ObjectOverrider can be implemented by an object so its ports can be overridden, BaseObject implements it
+type ObjectOverrider interface {
+	// OverrideValue eg; OverrideValue(21.5, "in"), use Runtime().Overrides() for a timed override with who set it and why
+	OverrideValue(value any, portID string) error
+	ReleaseOverride(portID string) error
+}
+
+type OverrideOpts struct {
+	// AuditSize how many override events are kept, the oldest are dropped; defaults to 1000
+	AuditSize int
+}
+
+// PortOverride is an active override of a port, with who set it and why
+type PortOverride struct {
+	ObjectUUID string    `json:"objectUUID"`
+	PortID     string    `json:"portID"`
+	Value      any       `json:"value"`
+	SetBy      string    `json:"setBy,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	SetAt      time.Time `json:"setAt"`
+	ExpiresAt  time.Time `json:"expiresAt,omitempty"` // zero when it is only released by a user
+}
+
+// OverrideEvent is a set, release or expiry of an override in the audit trail
+type OverrideEvent struct {
+	Action   string        `json:"action"`
+	By       string        `json:"by,omitempty"`
+	At       time.Time     `json:"at"`
+	Override *PortOverride `json:"override"`
+}
+
+type OverrideRequest struct {
+	ObjectUUID string
+	PortID     string
+	Value      any
+	// Duration or ExpiresAt releases the override with a timer, if both are empty it stays until it is released
+	Duration  time.Duration
+	ExpiresAt time.Time
+	SetBy     string
+	Reason    string
+}
+
+// OverrideManager keeps the active overrides of the runtime, and the audit trail of them
+type OverrideManager interface {
+	// Set overrides the port, an override of a port that is already overridden replaces it; eg Set(&OverrideRequest{ObjectUUID: uuid, PortID: "in", Value: 21.5, Duration: time.Hour, SetBy: "bob", Reason: "commissioning"})
+	Set(req *OverrideRequest) (*PortOverride, error)
+	// Release restores the value the port had before the override
+	Release(objectUUID, portID, releasedBy string) error
+	// ReleaseAll releases the active overrides, of the object when the uuid is not empty; it returns the released overrides
+	ReleaseAll(objectUUID, releasedBy string) ([]*PortOverride, error)
+	// List returns the active overrides, the oldest first
+	List() []*PortOverride
+	// Audit returns the override events, the oldest first
+	Audit() []*OverrideEvent
+	// Stop stops the timers, the overrides stay applied
+	Stop()
+}
+
+type activeOverride struct {
+	override *PortOverride
+	timer    *time.Timer
+}
+
+type overrideManager struct {
+	runtime     *RuntimeImpl
+	mutex       sync.Mutex
+	opts        *OverrideOpts
+	active      map[string]*activeOverride // by object uuid and port id
+	generations map[string]uint64          // bumped on each set, release and expiry of a port, so a set that was overtaken is not kept
+	audit       []*OverrideEvent
+}
+
+func newOverrideManager(r *RuntimeImpl, opts *OverrideOpts) *overrideManager {
+	if opts == nil {
+		opts = &OverrideOpts{}
+	}
+	if opts.AuditSize <= 0 {
+		opts.AuditSize = 1000
+	}
+	return &overrideManager{runtime: r, opts: opts, active: make(map[string]*activeOverride), generations: make(map[string]uint64)}
+}
+
+func (inst *RuntimeImpl) Overrides() OverrideManager {
+	return inst.overrides
+}
+
+func overrideKey(objectUUID, portID string) string {
+	return objectUUID + "/" + portID
+}
+
+func overrider(object Object) (ObjectOverrider, error) {
+	o, ok := object.(ObjectOverrider)
+	if !ok {
+		return nil, fmt.Errorf("object: %s does not support overrides", object.GetUUID())
+	}
+	return o, nil
+}
+
+func (m *overrideManager) Set(req *OverrideRequest) (*PortOverride, error) {
+	if req == nil {
+		return nil, errors.New("override request can not be empty")
+	}
+	object := m.runtime.GetByUUID(req.ObjectUUID)
+	if object == nil {
+		return nil, fmt.Errorf("not found object with uuid: %s", req.ObjectUUID)
+	}
+	o, err := overrider(object)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	override := &PortOverride{
+		ObjectUUID: req.ObjectUUID,
+		PortID:     req.PortID,
+		Value:      req.Value,
+		SetBy:      req.SetBy,
+		Reason:     req.Reason,
+		SetAt:      now,
+		ExpiresAt:  req.ExpiresAt,
+	}
+	if req.Duration > 0 {
+		override.ExpiresAt = now.Add(req.Duration)
+	}
+	if !override.ExpiresAt.IsZero() && !override.ExpiresAt.After(now) {
+		return nil, fmt.Errorf("override of object: %s port: %s expires in the past: %s", req.ObjectUUID, req.PortID, override.ExpiresAt.Format(time.RFC3339))
+	}
+	key := overrideKey(req.ObjectUUID, req.PortID)
+	m.mutex.Lock()
+	m.generations[key]++
+	generation := m.generations[key]
+	m.mutex.Unlock()
+	// the override is applied without the mutex, an output is published to the subscribers and they can call the manager
+	if err := o.OverrideValue(req.Value, req.PortID); err != nil {
+		return nil, err
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.generations[key] != generation {
+		return nil, fmt.Errorf("override of object: %s port: %s was set or released again while it was applied", req.ObjectUUID, req.PortID)
+	}
+	if previous, ok := m.active[key]; ok && previous.timer != nil {
+		previous.timer.Stop()
+	}
+	active := &activeOverride{override: override}
+	if !override.ExpiresAt.IsZero() {
+		active.timer = time.AfterFunc(override.ExpiresAt.Sub(now), func() { m.expire(key, override) })
+	}
+	m.active[key] = active
+	m.record(OverrideSet, req.SetBy, override)
+	return override, nil
+}
+
+// expire releases the override when its timer fires, if it was not replaced or released before
+func (m *overrideManager) expire(key string, override *PortOverride) {
+	m.mutex.Lock()
+	active, ok := m.active[key]
+	if !ok || active.override != override {
+		m.mutex.Unlock()
+		return
+	}
+	m.generations[key]++
+	delete(m.active, key)
+	m.record(OverrideExpired, "", override)
+	m.mutex.Unlock()
+	if o, ok := m.runtime.GetByUUID(override.ObjectUUID).(ObjectOverrider); ok {
+		if err := o.ReleaseOverride(override.PortID); err != nil {
+			log.Printf("override of object: %s port: %s expiry err: %v", override.ObjectUUID, override.PortID, err)
+		}
+	}
+}
+
+func (m *overrideManager) Release(objectUUID, portID, releasedBy string) error {
+	object := m.runtime.GetByUUID(objectUUID)
+	key := overrideKey(objectUUID, portID)
+	m.mutex.Lock()
+	m.generations[key]++
+	active, ok := m.active[key]
+	if ok {
+		if active.timer != nil {
+			active.timer.Stop()
+		}
+		delete(m.active, key)
+		m.record(OverrideReleased, releasedBy, active.override)
+	}
+	m.mutex.Unlock()
+	if object == nil {
+		if ok {
+			return nil
+		}
+		return fmt.Errorf("not found object with uuid: %s", objectUUID)
+	}
+	o, err := overrider(object)
+	if err == nil {
+		err = o.ReleaseOverride(portID)
+	}
+	if ok {
+		// the override is released from the manager, even if the object has already released it
+		return nil
+	}
+	if err == nil {
+		// an override that was set on the object directly
+		m.mutex.Lock()
+		m.record(OverrideReleased, releasedBy, &PortOverride{ObjectUUID: objectUUID, PortID: portID})
+		m.mutex.Unlock()
+	}
+	return err
+}
+
+func (m *overrideManager) ReleaseAll(objectUUID, releasedBy string) ([]*PortOverride, error) {
+	var released []*PortOverride
+	var errs []error
+	for _, override := range m.List() {
+		if objectUUID != "" && override.ObjectUUID != objectUUID {
+			continue
+		}
+		if err := m.Release(override.ObjectUUID, override.PortID, releasedBy); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		released = append(released, override)
+	}
+	return released, errors.Join(errs...)
+}
+
+func (m *overrideManager) List() []*PortOverride {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	var out []*PortOverride
+	for _, active := range m.active {
+		out = append(out, active.override)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].SetAt.Before(out[j].SetAt) })
+	return out
+}
+
+func (m *overrideManager) Audit() []*OverrideEvent {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]*OverrideEvent(nil), m.audit...)
+}
+
+func (m *overrideManager) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, active := range m.active {
+		if active.timer != nil {
+			active.timer.Stop()
+		}
+	}
+}
+
+// remove drops the overrides of the objects removed from the runtime, the objects are not called as they are deleted
+func (m *overrideManager) remove(objects []Object) {
+	if m == nil || len(objects) == 0 {
+		return
+	}
+	removed := make(map[string]bool, len(objects))
+	for _, object := range objects {
+		removed[object.GetUUID()] = true
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for key, active := range m.active {
+		if !removed[active.override.ObjectUUID] {
+			continue
+		}
+		if active.timer != nil {
+			active.timer.Stop()
+		}
+		delete(m.active, key)
+		m.record(OverrideReleased, "", active.override)
+	}
+	for key := range m.generations {
+		if objectUUID, _, _ := strings.Cut(key, "/"); removed[objectUUID] {
+			delete(m.generations, key)
+		}
+	}
+}
+
+// record must be called with the mutex held
+func (m *overrideManager) record(action, by string, override *PortOverride) {
+	m.audit = append(m.audit, &OverrideEvent{Action: action, By: by, At: time.Now(), Override: override})
+	if extra := len(m.audit) - m.opts.AuditSize; extra > 0 {
+		m.audit = append([]*OverrideEvent(nil), m.audit[extra:]...)
+	}
+}
+
+// handleOverrides lists, sets and releases the overrides of the runtime, the overrides are in the Data and as json in the Byte
+// eg; getOverrides --field=audit, setOverrides --uuid=abc --id=in --value=22 --duration=1h --by=bob --reason=commissioning, releaseOverrides --by=bob
+func (inst *RuntimeImpl) handleOverrides(parsedArgs *ParsedCommand) *CommandResponse {
+	var data any
+	switch parsedArgs.GetCommandType() {
+	case "get":
+		if parsedArgs.GetField() == "audit" {
+			audit := inst.Overrides().Audit()
+			data, inst.response.Count = audit, len(audit)
+		} else {
+			overrides := inst.Overrides().List()
+			data, inst.response.Count = overrides, len(overrides)
+		}
+	case "set":
+		override, err := inst.setOverride(parsedArgs)
+		if err != nil {
+			inst.response.Error = err.Error()
+			return inst.response
+		}
+		data, inst.response.Count = []*PortOverride{override}, 1
+	case "release":
+		by := inst.command.GetArgsByKey("by")
+		if parsedArgs.GetUUID() != "" && parsedArgs.GetID() != "" {
+			if err := inst.Overrides().Release(parsedArgs.GetUUID(), parsedArgs.GetID(), by); err != nil {
+				inst.response.Error = err.Error()
+				return inst.response
+			}
+			inst.response.Count = 1
+			return inst.response
+		}
+		released, err := inst.Overrides().ReleaseAll(parsedArgs.GetUUID(), by)
+		if err != nil {
+			inst.response.Error = err.Error()
+		}
+		data, inst.response.Count = released, len(released)
+	default:
+		inst.response.Error = fmt.Sprintf("unknown overrides command type: %s, use get, set or release", parsedArgs.GetCommandType())
+		return inst.response
+	}
+	inst.response.Data = data
+	inst.response.Byte, _ = json.Marshal(data)
+	return inst.response
+}
+
+func (inst *RuntimeImpl) setOverride(parsedArgs *ParsedCommand) (*PortOverride, error) {
+	object := inst.GetByUUID(parsedArgs.GetUUID())
+	if object == nil {
+		return nil, fmt.Errorf("not found object with uuid: %s", parsedArgs.GetUUID())
+	}
+	port := object.GetInput(parsedArgs.GetID())
+	if port == nil {
+		port = object.GetOutput(parsedArgs.GetID())
+	}
+	if port == nil {
+		return nil, fmt.Errorf("object: %s port: %s not found", parsedArgs.GetUUID(), parsedArgs.GetID())
+	}
+	value, err := overrideValue(parsedArgs.GetValue(), port.GetDataType())
+	if err != nil {
+		return nil, fmt.Errorf("object: %s port: %s err: %v", parsedArgs.GetUUID(), parsedArgs.GetID(), err)
+	}
+	req := &OverrideRequest{
+		ObjectUUID: parsedArgs.GetUUID(),
+		PortID:     parsedArgs.GetID(),
+		Value:      value,
+		SetBy:      inst.command.GetArgsByKey("by"),
+		Reason:     inst.command.GetArgsByKey("reason"),
+	}
+	if d := inst.command.GetArgsByKey("duration"); d != "" {
+		if req.Duration, err = time.ParseDuration(d); err != nil {
+			return nil, fmt.Errorf("override duration: %s err: %v", d, err)
+		}
+	}
+	if e := inst.command.GetArgsByKey("expiresAt"); e != "" {
+		if req.ExpiresAt, err = time.Parse(time.RFC3339, e); err != nil {
+			return nil, fmt.Errorf("override expiresAt: %s err: %v", e, err)
+		}
+	}
+	return inst.Overrides().Set(req)
+}
+
+// overrideValue converts the value of a command to the data type of the port
+func overrideValue(value string, dataType priority.Type) (any, error) {
+	v, err := CoerceValue(&runtime.PortValue{DataType: priority.TypeString, StringValue: &value}, dataType)
+	if err != nil {
+		return nil, err
+	}
+	out, ok := portValueAny(v)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert value: %s to %s", value, dataType)
+	}
+	if i, ok := out.(int32); ok {
+		return int(i), nil
+	}
+	return out, nil
+}
+
+func CommandGetOverrides() *ExtendedCommand {
+	c := NewCommand()
+	c.buildCommand("get", commandOverrides, "", "", false)
+	return c
+}
+
+func CommandGetOverridesAudit() *ExtendedCommand {
+	c := NewCommand()
+	c.buildCommand("get", commandOverrides, "field", "audit", false)
+	return c
+}
+
+// CommandSetOverride the duration can be empty to keep the override until it is released
+// eg; CommandSetOverride("abc", "in", "22.5", time.Hour, "bob", "commissioning")
+func CommandSetOverride(objectUUID, portID, value string, duration time.Duration, by, reason string) *ExtendedCommand {
+	c := NewCommand()
+	c.buildCommand("set", commandOverrides, "uuid", objectUUID, false)
+	c.Data["id"] = portID
+	c.Data["value"] = value
+	if duration > 0 {
+		c.Data["duration"] = duration.String()
+	}
+	c.Data["by"] = by
+	c.Data["reason"] = reason
+	return c
+}
+
+// CommandReleaseOverrides releases the override of the port, or all the overrides of the object when the port is empty,
+// or all the overrides of the runtime when both are empty
+func CommandReleaseOverrides(objectUUID, portID, by string) *ExtendedCommand {
+	c := NewCommand()
+	c.buildCommand("release", commandOverrides, "by", by, false)
+	if objectUUID != "" {
+		c.Data["uuid"] = objectUUID
+	}
+	if portID != "" {
+		c.Data["id"] = portID
+	}
+	return c
+}
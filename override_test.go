@@ -0,0 +1,179 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/payload"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
+	"testing"
+	"time"
+)
+
+func newOverrideRuntime(objects ...Object) *RuntimeImpl {
+	r := &RuntimeImpl{}
+	r.overrides = newOverrideManager(r, nil)
+	r.AddObjects(objects)
+	return r
+}
+
+func TestOverrideExpiry(t *testing.T) {
+	a := newBaseAdd("a", newTestBus())
+	_ = a.NewInputPort(NewPortString("name"))
+	_ = a.NewInputPort(NewPortBool("enable"))
+	r := newOverrideRuntime(a)
+	_ = a.InvokePayload(&payload.Payload{PortValue: &runtime.PortValue{PortID: "name", StringValue: ptr("pump")}})
+
+	override, err := r.Overrides().Set(&OverrideRequest{ObjectUUID: "a", PortID: "name", Value: "fan", Duration: 20 * time.Millisecond, SetBy: "bob", Reason: "commissioning"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Overrides().Set(&OverrideRequest{ObjectUUID: "a", PortID: "enable", Value: true, Duration: 20 * time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	if v := a.GetPortValue("name"); v.GetStringValue() != "fan" || a.GetInput("name").GetQuality() != payload.QualityOverridden {
+		t.Fatalf("expected the override: %v", v)
+	}
+	if override.ExpiresAt.IsZero() || len(r.Overrides().List()) != 2 {
+		t.Fatalf("expected the active overrides: %v", r.Overrides().List())
+	}
+
+	waitFor(t, func() bool { return len(r.Overrides().List()) == 0 })
+	if v := a.GetPortValue("name"); v.GetStringValue() != "pump" || a.GetInput("name").OverrideApplied {
+		t.Fatalf("expected the previous string to be restored: %v", v)
+	}
+	if v := a.GetPortValue("enable"); !v.GetIsNil() || v.BoolValue != nil {
+		t.Fatalf("expected the bool to be nil again: %v", v)
+	}
+	audit := r.Overrides().Audit()
+	if len(audit) != 4 || audit[0].Action != OverrideSet || audit[0].By != "bob" || audit[0].Override.Reason != "commissioning" {
+		t.Fatalf("unexpected audit: %v", audit)
+	}
+	if audit[2].Action != OverrideExpired || audit[3].Action != OverrideExpired {
+		t.Fatalf("expected the overrides to expire: %v %v", audit[2], audit[3])
+	}
+
+	if _, err := r.Overrides().Set(&OverrideRequest{ObjectUUID: "a", PortID: "name", Value: "fan", ExpiresAt: time.Now().Add(-time.Second)}); err == nil {
+		t.Fatal("expected an override that expired to fail")
+	}
+	if _, err := r.Overrides().Set(&OverrideRequest{ObjectUUID: "a", PortID: "name", Value: 1.0}); err == nil {
+		t.Fatal("expected a float override of a string to fail")
+	}
+}
+
+func TestOverrideHoldsValues(t *testing.T) {
+	a := newBaseAdd("a", newTestBus())
+	r := newOverrideRuntime(a)
+	_ = a.InvokePayload(&payload.Payload{PortValue: &runtime.PortValue{PortID: "in", FloatValue: ptr(1.0)}})
+	if _, err := r.Overrides().Set(&OverrideRequest{ObjectUUID: "a", PortID: "in", Value: 5.0, SetBy: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	// a replaced override keeps the value from before the first one
+	if _, err := r.Overrides().Set(&OverrideRequest{ObjectUUID: "a", PortID: "in", Value: 6.0, Duration: time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+	_ = a.InvokePayload(&payload.Payload{PortValue: &runtime.PortValue{PortID: "in", FloatValue: ptr(2.0)}})
+	if v := a.GetInput("in").GetValueFloat(); v != 6 {
+		t.Fatalf("expected the override to be kept, got %v", v)
+	}
+	if err := r.Overrides().Release("a", "in", "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if v := a.GetInput("in").GetValueFloat(); v != 2 || a.GetInput("in").GetQuality() != payload.QualityGood {
+		t.Fatalf("expected the value that came in during the override, got %v", v)
+	}
+	if err := r.Overrides().Release("a", "in", "alice"); err == nil {
+		t.Fatal("expected a release of a port that is not overridden to fail")
+	}
+	if audit := r.Overrides().Audit(); len(audit) != 3 || audit[2].Action != OverrideReleased || audit[2].By != "alice" {
+		t.Fatalf("unexpected audit: %v", audit)
+	}
+}
+
+func TestOverrideOutput(t *testing.T) {
+	a, values := newPublishTest(t, NewPortFloat("level"))
+	r := newOverrideRuntime(a)
+	_ = a.SetOutput("level", 1.0)
+	if _, err := r.Overrides().Set(&OverrideRequest{ObjectUUID: "a", PortID: "level", Value: 9.0}); err != nil {
+		t.Fatal(err)
+	}
+	_ = a.SetOutput("level", 2.0)
+	waitFor(t, func() bool { return len(values()) == 2 })
+	if err := r.Overrides().Release("a", "level", ""); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return len(values()) == 3 })
+	if got := values(); !slices.Equal(got, []float64{1, 9, 2}) {
+		t.Fatalf("expected the override and the held value to be published, got %v", got)
+	}
+}
+
+func TestOverridesCommand(t *testing.T) {
+	a, c := newBaseAdd("a", newTestBus()), newBaseAdd("c", newTestBus())
+	r := newOverrideRuntime(a, c)
+	if resp := r.CommandObject(CommandSetOverride("a", "in", "22.5", time.Hour, "bob", "commissioning")); resp.Error != "" || resp.Count != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp := r.CommandObject(CommandSetOverride("c", "in", "abc", 0, "bob", "")); resp.Error == "" {
+		t.Fatal("expected a value that is not a float to fail")
+	}
+	_ = r.CommandObject(CommandSetOverride("c", "out", "3", 0, "bob", ""))
+	if v := a.GetInput("in").GetValueFloat(); v != 22.5 {
+		t.Fatalf("expected the override, got %v", v)
+	}
+
+	resp := r.CommandObject(CommandGetOverrides())
+	overrides, ok := resp.Data.([]*PortOverride)
+	if !ok || resp.Count != 2 || overrides[0].SetBy != "bob" || overrides[0].ExpiresAt.IsZero() || len(resp.Byte) == 0 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp := r.CommandObject(CommandReleaseOverrides("", "", "alice")); resp.Error != "" || resp.Count != 2 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if a.GetInput("in").OverrideApplied || c.GetOutput("out").OverrideApplied {
+		t.Fatal("expected the overrides to be released")
+	}
+	if resp := r.CommandObject(CommandGetOverridesAudit()); resp.Count != 4 {
+		t.Fatalf("unexpected audit: %+v", resp)
+	}
+}
+
+func TestOverrideSubscriberCallsManager(t *testing.T) {
+	b := newTestBus()
+	a := newBaseAdd("a", b)
+	var r *RuntimeImpl
+	listed := make(chan int, 10)
+	c := NewBaseObject(&runtime.Info{ObjectID: "c"}, &BaseObjectOpts{Bus: b})
+	_ = c.SetMeta(&runtime.Meta{ObjectUUID: "c"})
+	_ = c.NewInputPort(NewPortFloatCallBack("in", func(portID string, p *payload.Payload) {
+		listed <- len(r.Overrides().List())
+	}))
+	c.AddSubscriptionConnection("a", "out", "c", "in")
+	r = newOverrideRuntime(a)
+
+	done := make(chan error)
+	go func() {
+		_, err := r.Overrides().Set(&OverrideRequest{ObjectUUID: "a", PortID: "out", Value: 9.0})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a subscriber of the overridden output to be able to call the manager")
+	}
+	if len(listed) != 1 {
+		t.Fatal("expected the override to be published")
+	}
+
+	// the overrides of a deleted object are dropped
+	if err := r.DeleteByUUID("a"); err != nil {
+		t.Fatal(err)
+	}
+	if overrides := r.Overrides().List(); len(overrides) != 0 {
+		t.Fatalf("expected the override of the deleted object to be dropped: %v", overrides)
+	}
+	if audit := r.Overrides().Audit(); len(audit) != 2 || audit[1].Action != OverrideReleased {
+		t.Fatalf("unexpected audit: %v", audit)
+	}
+}
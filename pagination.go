@@ -2,6 +2,7 @@ package rxlib
 
 import (
 	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
 )
 
 type ObjectValuesPagination struct {
@@ -51,7 +52,7 @@ func (inst *RuntimeImpl) ObjectsPagination(pageNumber, pageSize int) *ObjectPagi
 	if end > len(inst.objects) {
 		end = len(inst.objects)
 	}
-	pagedObjects := inst.objects[start:end]
+	pagedObjects := slices.Clone(inst.objects[start:end])
 
 	return &ObjectPagination{
 		Objects:    pagedObjects,
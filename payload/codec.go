@@ -0,0 +1,278 @@
+package payload
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"github.com/ugorji/go/codec"
+	"google.golang.org/protobuf/proto"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	CodecJSON     = "json"
+	CodecProtobuf = "protobuf"
+	CodecCBOR     = "cbor"
+	CodecMsgpack  = "msgpack"
+)
+
+// Codec encodes a payload to send it outside the runtime, eg; protobuf for the cloud and json for the UI. The json, cbor and msgpack
+// codecs keep the exported fields of the payload, see Message; the protobuf codec only has the runtime.PortValue, so the ExpiresAt,
+// FromOverride, OverrideFloat and TransformationExistingValue fields are dropped and the decoded Timestamp is the source time of the value
+type Codec interface {
+	Name() string
+	ContentType() string
+	Marshal(p *Payload) ([]byte, error)
+	Unmarshal(data []byte) (*Payload, error)
+}
+
+var codecs = struct {
+	mutex  sync.RWMutex
+	byName map[string]Codec
+}{byName: make(map[string]Codec)}
+
+func init() {
+	RegisterCodec(&jsonCodec{})
+	RegisterCodec(&protobufCodec{})
+	RegisterCodec(newHandleCodec(CodecCBOR, "application/cbor", &codec.CborHandle{}))
+	msgpack := &codec.MsgpackHandle{WriteExt: true}
+	msgpack.RawToString = true
+	RegisterCodec(newHandleCodec(CodecMsgpack, "application/msgpack", msgpack))
+}
+
+// RegisterCodec adds or replaces the codec by its name
+func RegisterCodec(c Codec) {
+	codecs.mutex.Lock()
+	defer codecs.mutex.Unlock()
+	codecs.byName[c.Name()] = c
+}
+
+func GetCodec(name string) (Codec, error) {
+	codecs.mutex.RLock()
+	defer codecs.mutex.RUnlock()
+	c, ok := codecs.byName[name]
+	if !ok {
+		return nil, fmt.Errorf("payload codec: %s not found", name)
+	}
+	return c, nil
+}
+
+// CodecNames returns the names of the registered codecs, sorted
+func CodecNames() []string {
+	codecs.mutex.RLock()
+	defer codecs.mutex.RUnlock()
+	var out []string
+	for name := range codecs.byName {
+		out = append(out, name)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// Encode encodes the payload with the codec, the message starts with the codec name so Decode() knows how to decode it; eg "cbor:<data>"
+func Encode(codecName string, p *Payload) ([]byte, error) {
+	if p == nil {
+		return nil, errors.New("payload can not be empty")
+	}
+	c, err := GetCodec(codecName)
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("payload codec: %s err: %v", codecName, err)
+	}
+	return append([]byte(codecName+":"), data...), nil
+}
+
+// Decode decodes a message from Encode() with the codec named in it, and returns the codec name
+func Decode(message []byte) (*Payload, string, error) {
+	i := bytes.IndexByte(message, ':')
+	if i <= 0 {
+		return nil, "", errors.New("payload message has no codec name")
+	}
+	name := string(message[:i])
+	c, err := GetCodec(name)
+	if err != nil {
+		return nil, name, err
+	}
+	p, err := c.Unmarshal(message[i+1:])
+	if err != nil {
+		return nil, name, fmt.Errorf("payload codec: %s err: %v", name, err)
+	}
+	return p, name, nil
+}
+
+// Encode see Encode()
+func (p *Payload) Encode(codecName string) ([]byte, error) {
+	return Encode(codecName, p)
+}
+
+// Message is the payload as it is encoded by the json, cbor and msgpack codecs
+type Message struct {
+	FromPortID                        string     `json:"fromPortID,omitempty"`
+	FromObjectUUID                    string     `json:"fromObjectUUID,omitempty"`
+	FromOverride                      bool       `json:"fromOverride,omitempty"`
+	OverrideFloat                     float64    `json:"overrideFloat,omitempty"`
+	TransformationExistingValueFloat  *float64   `json:"transformationExistingValueFloat,omitempty"`
+	TransformationExistingValueInt    *int       `json:"transformationExistingValueInt,omitempty"`
+	TransformationExistingValueString *string    `json:"transformationExistingValueString,omitempty"`
+	TransformationExistingValueBool   *bool      `json:"transformationExistingValueBool,omitempty"`
+	Timestamp                         time.Time  `json:"timestamp"`
+	ExpiresAt                         *time.Time `json:"expiresAt,omitempty"`
+	ObjectUUID                        string     `json:"objectUUID,omitempty"`
+	PortID                            string     `json:"portID,omitempty"`
+	PortIDs                           []string   `json:"portIDs,omitempty"`
+	DataType                          string     `json:"dataType,omitempty"`
+	IsNil                             bool       `json:"isNil,omitempty"`
+	TransformationApplied             bool       `json:"transformationApplied,omitempty"`
+	Data                              []byte     `json:"data,omitempty"` // the value set by NewPayload() and ApplyData()
+	FloatValue                        *float64   `json:"floatValue,omitempty"`
+	StringValue                       *string    `json:"stringValue,omitempty"`
+	BoolValue                         *bool      `json:"boolValue,omitempty"`
+	IntValue                          *int32     `json:"intValue,omitempty"`
+	JsonValue                         *string    `json:"jsonValue,omitempty"`
+	DisplayValue                      *string    `json:"displayValue,omitempty"`
+	Quality                           string     `json:"quality,omitempty"`
+	SourceTimestamp                   string     `json:"sourceTimestamp,omitempty"`
+}
+
+func NewMessage(p *Payload) *Message {
+	m := &Message{
+		FromPortID:                        p.FromPortID,
+		FromObjectUUID:                    p.FromObjectUUID,
+		FromOverride:                      p.FromOverride,
+		OverrideFloat:                     p.OverrideFloat,
+		TransformationExistingValueFloat:  p.TransformationExistingValueFloat,
+		TransformationExistingValueInt:    p.TransformationExistingValueInt,
+		TransformationExistingValueString: p.TransformationExistingValueString,
+		TransformationExistingValueBool:   p.TransformationExistingValueBool,
+		Timestamp:                         p.Timestamp,
+	}
+	if !p.ExpiresAt.IsZero() {
+		expiresAt := p.ExpiresAt
+		m.ExpiresAt = &expiresAt
+	}
+	if v := p.PortValue; v != nil {
+		m.ObjectUUID, m.PortID, m.PortIDs, m.DataType, m.IsNil, m.TransformationApplied = v.ObjectUUID, v.PortID, v.PortIDs, v.DataType, v.IsNil, v.TransformationApplied
+		m.Data = v.Data
+		m.FloatValue, m.StringValue, m.BoolValue, m.IntValue = v.FloatValue, v.StringValue, v.BoolValue, v.IntValue
+		m.JsonValue, m.DisplayValue, m.Quality, m.SourceTimestamp = v.JsonValue, v.DisplayValue, v.Quality, v.SourceTimestamp
+	}
+	return m
+}
+
+func (m *Message) Payload() *Payload {
+	p := &Payload{
+		FromPortID:                        m.FromPortID,
+		FromObjectUUID:                    m.FromObjectUUID,
+		FromOverride:                      m.FromOverride,
+		OverrideFloat:                     m.OverrideFloat,
+		TransformationExistingValueFloat:  m.TransformationExistingValueFloat,
+		TransformationExistingValueInt:    m.TransformationExistingValueInt,
+		TransformationExistingValueString: m.TransformationExistingValueString,
+		TransformationExistingValueBool:   m.TransformationExistingValueBool,
+		Timestamp:                         m.Timestamp,
+		PortValue: &runtime.PortValue{
+			ObjectUUID:            m.ObjectUUID,
+			PortID:                m.PortID,
+			PortIDs:               m.PortIDs,
+			DataType:              m.DataType,
+			IsNil:                 m.IsNil,
+			TransformationApplied: m.TransformationApplied,
+			Data:                  m.Data,
+			FloatValue:            m.FloatValue,
+			StringValue:           m.StringValue,
+			BoolValue:             m.BoolValue,
+			IntValue:              m.IntValue,
+			JsonValue:             m.JsonValue,
+			DisplayValue:          m.DisplayValue,
+			Quality:               m.Quality,
+			SourceTimestamp:       m.SourceTimestamp,
+		},
+	}
+	if m.ExpiresAt != nil {
+		p.ExpiresAt = *m.ExpiresAt
+	}
+	return p
+}
+
+type jsonCodec struct{}
+
+func (c *jsonCodec) Name() string        { return CodecJSON }
+func (c *jsonCodec) ContentType() string { return "application/json" }
+
+func (c *jsonCodec) Marshal(p *Payload) ([]byte, error) {
+	return json.Marshal(NewMessage(p))
+}
+
+func (c *jsonCodec) Unmarshal(data []byte) (*Payload, error) {
+	var m Message
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m.Payload(), nil
+}
+
+// protobufCodec encodes the runtime.PortValue, the FromObjectUUID and FromPortID are its ObjectUUID and PortID. The PortValue has no
+// field for the Timestamp and ExpiresAt, so the ExpiresAt is dropped and the Timestamp is only used when the value has no source time
+type protobufCodec struct{}
+
+func (c *protobufCodec) Name() string        { return CodecProtobuf }
+func (c *protobufCodec) ContentType() string { return "application/x-protobuf" }
+
+func (c *protobufCodec) Marshal(p *Payload) ([]byte, error) {
+	v := &runtime.PortValue{}
+	if p.PortValue != nil {
+		v = proto.Clone(p.PortValue).(*runtime.PortValue)
+	}
+	if p.FromObjectUUID != "" {
+		v.ObjectUUID, v.PortID = p.FromObjectUUID, p.FromPortID
+	}
+	if v.SourceTimestamp == "" && !p.Timestamp.IsZero() {
+		v.SourceTimestamp = p.Timestamp.Format(time.RFC3339Nano)
+	}
+	return proto.Marshal(v)
+}
+
+func (c *protobufCodec) Unmarshal(data []byte) (*Payload, error) {
+	v := &runtime.PortValue{}
+	if err := proto.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	p := &Payload{FromObjectUUID: v.ObjectUUID, FromPortID: v.PortID, PortValue: v}
+	p.Timestamp = p.SourceTime()
+	return p, nil
+}
+
+// handleCodec encodes the Message with a ugorji codec handle, used for cbor and msgpack
+type handleCodec struct {
+	name        string
+	contentType string
+	handle      codec.Handle
+}
+
+func newHandleCodec(name, contentType string, handle codec.Handle) *handleCodec {
+	return &handleCodec{name: name, contentType: contentType, handle: handle}
+}
+
+func (c *handleCodec) Name() string        { return c.name }
+func (c *handleCodec) ContentType() string { return c.contentType }
+
+func (c *handleCodec) Marshal(p *Payload) ([]byte, error) {
+	var out []byte
+	err := codec.NewEncoderBytes(&out, c.handle).Encode(NewMessage(p))
+	return out, err
+}
+
+func (c *handleCodec) Unmarshal(data []byte) (*Payload, error) {
+	var m Message
+	if err := codec.NewDecoderBytes(data, c.handle).Decode(&m); err != nil {
+		return nil, err
+	}
+	return m.Payload(), nil
+}
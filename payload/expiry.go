@@ -0,0 +1,41 @@
+package payload
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrExpired is returned to a subscriber when a payload is past its TTL, use errors.Is to check
+var ErrExpired = errors.New("payload has expired")
+
+// SetTTL sets the payload to expire after the ttl from now, a ttl of 0 clears it
+func (p *Payload) SetTTL(ttl time.Duration) *Payload {
+	if ttl <= 0 {
+		p.ExpiresAt = time.Time{}
+		return p
+	}
+	p.ExpiresAt = time.Now().Add(ttl)
+	return p
+}
+
+// Expiry returns when the payload expires for a subscriber with a ttl, being the earliest of the
+// payload ExpiresAt and its Timestamp plus the ttl; it is zero when it never expires
+func (p *Payload) Expiry(ttl time.Duration) time.Time {
+	expiry := p.ExpiresAt
+	if ttl > 0 && !p.Timestamp.IsZero() {
+		if t := p.Timestamp.Add(ttl); expiry.IsZero() || t.Before(expiry) {
+			expiry = t
+		}
+	}
+	return expiry
+}
+
+// CheckExpiry returns an error wrapping ErrExpired if the payload is expired for a subscriber with a ttl
+func (p *Payload) CheckExpiry(ttl time.Duration, now time.Time) error {
+	expiry := p.Expiry(ttl)
+	if expiry.IsZero() || now.Before(expiry) {
+		return nil
+	}
+	return fmt.Errorf("%w: port: %s expired at: %s, %s ago", ErrExpired, p.FromPortID, expiry.Format(time.RFC3339Nano), now.Sub(expiry))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
 	"math"
 	"reflect"
+	"time"
 )
 
 type Body struct {
@@ -29,6 +30,10 @@ type Payload struct {
 	TransformationExistingValueInt    *int
 	TransformationExistingValueString *string
 	TransformationExistingValueBool   *bool
+
+	// Timestamp is when the payload was published, ExpiresAt is zero when the payload never expires
+	Timestamp time.Time
+	ExpiresAt time.Time
 	*runtime.PortValue
 	body *Body
 }
@@ -1,8 +1,12 @@
 package payload
 
 import (
+	"errors"
 	"fmt"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
 	"testing"
+	"time"
 )
 
 func TestNewPayload(t *testing.T) {
@@ -45,3 +49,127 @@ func TestNewPayloadJSON(t *testing.T) {
 	fmt.Println(person)
 
 }
+
+func TestPayloadExpiry(t *testing.T) {
+	now := time.Now()
+	p := &Payload{FromPortID: "out", Timestamp: now.Add(-time.Minute)}
+	if err := p.CheckExpiry(0, now); err != nil {
+		t.Fatalf("expected no expiry without a ttl got: %v", err)
+	}
+	if err := p.CheckExpiry(2*time.Minute, now); err != nil {
+		t.Fatalf("expected the payload to be in the ttl got: %v", err)
+	}
+	if err := p.CheckExpiry(time.Second, now); !errors.Is(err, ErrExpired) {
+		t.Fatalf("expected ErrExpired got: %v", err)
+	}
+
+	// the payload expiry is used when it is before the subscriber ttl
+	p.SetTTL(time.Millisecond)
+	if err := p.CheckExpiry(time.Hour, now.Add(time.Second)); !errors.Is(err, ErrExpired) {
+		t.Fatalf("expected ErrExpired got: %v", err)
+	}
+	p.SetTTL(0)
+	if !p.ExpiresAt.IsZero() {
+		t.Fatal("expected the expiry to be cleared")
+	}
+}
+
+func TestPayloadQuality(t *testing.T) {
+	p := &Payload{}
+	if p.ValueQuality() != QualityUncertain || !p.SourceTime().IsZero() {
+		t.Fatal("expected a payload without a value to be uncertain")
+	}
+	now := time.Now()
+	p.SetValueQuality(QualityGood).SetSourceTime(now)
+	if !p.IsTrusted() || !p.SourceTime().Equal(now) {
+		t.Fatalf("unexpected source time: %v", p.SourceTime())
+	}
+	if q := WorstQuality(QualityGood, QualityOverridden, QualityStale, QualityUncertain); q != QualityStale || q.IsTrusted() {
+		t.Fatalf("expected stale got: %s", q)
+	}
+	if q := WorstQuality(); q != QualityGood {
+		t.Fatalf("expected good got: %s", q)
+	}
+}
+
+func TestPayloadCodecs(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	read, expires := now.Add(-time.Minute), now.Add(time.Hour)
+	f := 21.5
+	p := &Payload{FromPortID: "out", FromObjectUUID: "a", Timestamp: now, ExpiresAt: expires, PortValue: &runtime.PortValue{DataType: "float", FloatValue: &f}}
+	p.SetValueQuality(QualityStale).SetSourceTime(read)
+	for _, name := range CodecNames() {
+		message, err := p.Encode(name)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		decoded, codecName, err := Decode(message)
+		if err != nil || codecName != name {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if decoded.FromObjectUUID != "a" || decoded.FromPortID != "out" || decoded.GetFloatValue() != f ||
+			decoded.ValueQuality() != QualityStale || !decoded.SourceTime().Equal(read) {
+			t.Fatalf("%s: unexpected payload: %+v %v", name, decoded, decoded.PortValue)
+		}
+		// the protobuf codec has no fields for the timestamp and expiry, see Codec
+		timestamp, expiresAt := now, expires
+		if name == CodecProtobuf {
+			timestamp, expiresAt = read, time.Time{}
+		}
+		if !decoded.Timestamp.Equal(timestamp) || !decoded.ExpiresAt.Equal(expiresAt) {
+			t.Fatalf("%s: unexpected timestamp: %v expires at: %v", name, decoded.Timestamp, decoded.ExpiresAt)
+		}
+	}
+	if names := CodecNames(); !slices.Equal(names, []string{CodecCBOR, CodecJSON, CodecMsgpack, CodecProtobuf}) {
+		t.Fatalf("unexpected codecs: %v", names)
+	}
+	if _, _, err := Decode([]byte("xml:<a/>")); err == nil {
+		t.Fatal("expected an unknown codec to fail")
+	}
+}
+
+func TestPayloadCodecsData(t *testing.T) {
+	existing, text := 20.0, "abc"
+	for _, body := range []*Body{{DataType: "float", Data: 21.5}, {DataType: "bool", Data: true}, {DataType: "string", Data: "pump"}, {DataType: "json", Data: map[string]any{"a": 1.0}}} {
+		p, err := NewPayload(&Body{PortID: "in", DataType: body.DataType})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p.ApplyData(body.Data); err != nil {
+			t.Fatal(err)
+		}
+		p.PortIDs = []string{"in", "in-2"}
+		p.FromOverride, p.OverrideFloat = true, 3
+		p.SetTransformationExistingValueFloat(&existing)
+		p.SetTransformationExistingValueString(&text)
+		for _, name := range CodecNames() {
+			message, err := p.Encode(name)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			decoded, _, err := Decode(message)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !slices.Equal(decoded.Data, p.Data) || !slices.Equal(decoded.PortIDs, p.PortIDs) {
+				t.Fatalf("%s %s: unexpected data: %v %v", name, body.DataType, decoded.Data, decoded.PortIDs)
+			}
+			if name == CodecProtobuf {
+				continue
+			}
+			if !decoded.FromOverride || decoded.OverrideFloat != 3 || decoded.TransformationExistingValueFloat == nil ||
+				*decoded.TransformationExistingValueFloat != existing || decoded.TransformationExistingValueString == nil {
+				t.Fatalf("%s: unexpected payload: %+v", name, decoded)
+			}
+		}
+	}
+
+	p, _ := NewPayload(&Body{DataType: "float", Data: 21.5})
+	for _, name := range []string{CodecJSON, CodecCBOR, CodecMsgpack} {
+		message, _ := p.Encode(name)
+		decoded, _, _ := Decode(message)
+		if v, err := decoded.ToFloat(); err != nil || v != 21.5 {
+			t.Fatalf("%s: unexpected float: %v %v", name, v, err)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package payload
+
+import (
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"time"
+)
+
+// Quality is if the value of a payload can be trusted, it is kept in the PortValue so it is sent with the value
+type Quality string
+
+const (
+	QualityGood       Quality = "good"
+	QualityUncertain  Quality = "uncertain"    // eg; a value restored after a restart, or that has not been read yet
+	QualityStale      Quality = "stale"        // the value is older than the max age of the port
+	QualityCommFault  Quality = "comm-fault"   // the value could not be read from its source
+	QualityOverridden Quality = "overridden"   // the value was set by a user and not by its source
+	QualityOutOfRange Quality = "out-of-range" // the value was outside the min/max of the port and was limited
+)
+
+// qualityRank orders the qualities from the most to the least trusted
+var qualityRank = map[Quality]int{
+	QualityGood:       0,
+	QualityOverridden: 1,
+	QualityUncertain:  2,
+	QualityOutOfRange: 3,
+	QualityStale:      4,
+	QualityCommFault:  5,
+}
+
+// IsTrusted is true for a good or overridden value, downstream logic and alarms should not act on the others
+func (q Quality) IsTrusted() bool {
+	return q == "" || q == QualityGood || q == QualityOverridden
+}
+
+// WorstQuality returns the least trusted of the qualities, eg; the quality of an output calculated from a few inputs
+func WorstQuality(qualities ...Quality) Quality {
+	worst := QualityGood
+	for _, q := range qualities {
+		if q == "" {
+			q = QualityGood
+		}
+		if rank, ok := qualityRank[q]; !ok || rank > qualityRank[worst] {
+			worst = q
+		}
+	}
+	return worst
+}
+
+// ValueQuality returns the quality of the value, an empty quality is good as it was sent by a version without them
+func (p *Payload) ValueQuality() Quality {
+	if p == nil || p.PortValue == nil {
+		return QualityUncertain
+	}
+	if p.PortValue.Quality == "" {
+		return QualityGood
+	}
+	return Quality(p.PortValue.Quality)
+}
+
+func (p *Payload) SetValueQuality(quality Quality) *Payload {
+	if p.PortValue == nil {
+		p.PortValue = &runtime.PortValue{}
+	}
+	p.PortValue.Quality = string(quality)
+	return p
+}
+
+// IsTrusted see Quality.IsTrusted()
+func (p *Payload) IsTrusted() bool {
+	return p.ValueQuality().IsTrusted()
+}
+
+// SourceTime is when the value was read or calculated at its source, it is zero when it is not known
+func (p *Payload) SourceTime() time.Time {
+	if p == nil || p.PortValue == nil || p.PortValue.SourceTimestamp == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, p.PortValue.SourceTimestamp)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func (p *Payload) SetSourceTime(t time.Time) *Payload {
+	if p.PortValue == nil {
+		p.PortValue = &runtime.PortValue{}
+	}
+	if t.IsZero() {
+		p.PortValue.SourceTimestamp = ""
+	} else {
+		p.PortValue.SourceTimestamp = t.Format(time.RFC3339Nano)
+	}
+	return p
+}
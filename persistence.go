@@ -0,0 +1,367 @@
+package rxlib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/libs/storage"
+	"log"
+	"reflect"
+	"sync"
+	"time"
+)
+
+const PortValuesTable = "ros_port_values"
+
+const (
+	defaultPersistenceDebounce = 2 * time.Second
+	defaultPersistenceInterval = time.Minute
+)
+
+// PortPersistence saves the values of the ports with EnablePersistence set, so they can be restored after a restart
+type PortPersistence interface {
+	// Restore calls RestorePersistedValues on each object with the last saved value of each port; this is used at boot
+	Restore() []error
+	// RestoreObject calls RestorePersistedValues on an object with the last saved value of each port; this is used when an object is added after boot
+	RestoreObject(object Object) []error
+	// PortUpdated is called when a persisted port value has changed, the value is saved after the debounce
+	PortUpdated(objectUUID, portID string)
+	// Snapshot checks all the objects and saves any persisted port value that has changed
+	Snapshot() []error
+	// SaveObject saves any persisted port value of the object that has changed
+	SaveObject(object Object) error
+	// Values returns the saved values of a port, the newest is last
+	Values(objectUUID, portID string) ([]*PersistedPortValue, error)
+	// Latest returns the last saved value of each port of an object
+	Latest(objectUUID string) ([]*ObjectPersistenceValue, error)
+	// Start the loop that takes a snapshot on the interval
+	Start()
+	// Stop the snapshot loop and save anything that is pending
+	Stop()
+}
+
+type PersistenceOpts struct {
+	Debounce time.Duration // how long to wait after a port update before saving; default 2 sec
+	Interval time.Duration // how often all the objects are checked for changed values; default 1 min
+}
+
+// PersistedPortValue is a saved port value
+type PersistedPortValue struct {
+	*ObjectPersistenceValue
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// persistedPort is what is stored in the db for each port
+type persistedPort struct {
+	ObjectUUID string                `json:"objectUUID"`
+	PortID     string                `json:"portID"`
+	Values     []*PersistedPortValue `json:"values"`
+}
+
+type portPersistence struct {
+	runtime *RuntimeImpl
+	opts    *PersistenceOpts
+	mutex   sync.Mutex
+	saving  sync.Mutex                         // held across the read, append and write of a port so concurrent saves don't lose values
+	last    map[string]*ObjectPersistenceValue // the last saved value of each port
+	pending map[string][2]string               // ports waiting for the debounce; key -> object uuid, port id
+	timer   *time.Timer
+	stop    chan struct{}
+}
+
+func newPortPersistence(r *RuntimeImpl, opts *PersistenceOpts) *portPersistence {
+	if opts == nil {
+		opts = &PersistenceOpts{}
+	}
+	if opts.Debounce <= 0 {
+		opts.Debounce = defaultPersistenceDebounce
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = defaultPersistenceInterval
+	}
+	return &portPersistence{
+		runtime: r,
+		opts:    opts,
+		last:    make(map[string]*ObjectPersistenceValue),
+		pending: make(map[string][2]string),
+	}
+}
+
+func portValueKey(objectUUID, portID string) string {
+	return fmt.Sprintf("%s:%s", objectUUID, portID)
+}
+
+func (inst *RuntimeImpl) Persistence() PortPersistence {
+	if inst.persistence == nil {
+		return nil
+	}
+	return inst.persistence
+}
+
+// SavePersistedValues saves the current value of each port with persistence enabled
+func (inst *RuntimeImpl) SavePersistedValues(object Object) error {
+	return inst.persistence.SaveObject(object)
+}
+
+// GetPersistedValues returns the last saved value of each port of an object
+func (inst *RuntimeImpl) GetPersistedValues(objectUUID string) ([]*ObjectPersistenceValue, error) {
+	return inst.persistence.Latest(objectUUID)
+}
+
+func (p *portPersistence) storage() (storage.Storage, error) {
+	db := p.runtime.Storage()
+	if db == nil {
+		return nil, errors.New("runtime storage is empty")
+	}
+	return db, nil
+}
+
+func (p *portPersistence) Restore() []error {
+	var errs []error
+	for _, object := range p.runtime.Get() {
+		errs = append(errs, p.RestoreObject(object)...)
+	}
+	return errs
+}
+
+func (p *portPersistence) RestoreObject(object Object) []error {
+	if object == nil {
+		return []error{errors.New("object can not be empty")}
+	}
+	db, err := p.storage()
+	if err != nil {
+		return []error{err}
+	}
+	rows, err := db.RowsByGroup(PortValuesTable, object.GetUUID())
+	if err != nil {
+		return []error{fmt.Errorf("restore object: %s err: %v", object.GetUUID(), err)}
+	}
+	var errs []error
+	for _, row := range rows {
+		saved, err := decodePersistedPort(row)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if len(saved.Values) == 0 {
+			continue
+		}
+		value := saved.Values[len(saved.Values)-1].ObjectPersistenceValue
+		if value == nil {
+			continue
+		}
+		if err := object.RestorePersistedValues(value); err != nil {
+			errs = append(errs, fmt.Errorf("restore object: %s port: %s err: %v", object.GetUUID(), saved.PortID, err))
+			continue
+		}
+		p.mutex.Lock()
+		p.last[row.Key] = value
+		p.mutex.Unlock()
+	}
+	return errs
+}
+
+// restoreObjects restores the objects added after boot, the errors are logged as there is no caller to return them to
+func (inst *RuntimeImpl) restoreObjects(objects []Object) {
+	if inst.persistence == nil || inst.Storage() == nil {
+		return
+	}
+	for _, object := range objects {
+		for _, err := range inst.persistence.RestoreObject(object) {
+			log.Printf("runtime restore persisted values: %v", err)
+		}
+	}
+}
+
+func (p *portPersistence) PortUpdated(objectUUID, portID string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.pending[portValueKey(objectUUID, portID)] = [2]string{objectUUID, portID}
+	if p.timer == nil {
+		p.timer = time.AfterFunc(p.opts.Debounce, p.flush)
+	}
+}
+
+// flush saves the ports that have been updated since the last debounce
+func (p *portPersistence) flush() {
+	p.mutex.Lock()
+	pending := p.pending
+	p.pending = make(map[string][2]string)
+	p.timer = nil
+	p.mutex.Unlock()
+
+	for _, port := range pending {
+		object := p.runtime.GetByUUID(port[0])
+		if object == nil {
+			continue
+		}
+		for _, persisted := range object.PortsWithPersistenceEnabled() {
+			if persisted.GetID() != port[1] {
+				continue
+			}
+			if err := p.savePort(object, persisted); err != nil {
+				log.Printf("port persistence: %v", err)
+			}
+		}
+	}
+}
+
+func (p *portPersistence) Snapshot() []error {
+	var errs []error
+	for _, object := range p.runtime.Get() {
+		if err := p.SaveObject(object); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (p *portPersistence) SaveObject(object Object) error {
+	if object == nil {
+		return errors.New("object can not be empty")
+	}
+	for _, port := range object.PortsWithPersistenceEnabled() {
+		if err := p.savePort(object, port); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// savePort adds the port value to the saved values if it has changed, and drops the oldest past MaxPersistenceCount; the value
+// is a copy from the object, as the object can be writing the port
+func (p *portPersistence) savePort(object Object, port *Port) error {
+	objectUUID := object.GetUUID()
+	key := portValueKey(objectUUID, port.GetID())
+	value := NewObjectPersistenceValue(port, object.GetPortValue(port.GetID()))
+	p.saving.Lock()
+	defer p.saving.Unlock()
+	p.mutex.Lock()
+	unchanged := reflect.DeepEqual(p.last[key], value)
+	p.mutex.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	db, err := p.storage()
+	if err != nil {
+		return err
+	}
+	saved := &persistedPort{ObjectUUID: objectUUID, PortID: port.GetID()}
+	row, err := db.GetRow(PortValuesTable, key)
+	if err != nil {
+		return fmt.Errorf("persist object: %s port: %s err: %v", objectUUID, port.GetID(), err)
+	}
+	if row != nil {
+		if saved, err = decodePersistedPort(row); err != nil {
+			return err
+		}
+	}
+	now := time.Now()
+	saved.Values = append(saved.Values, &PersistedPortValue{ObjectPersistenceValue: value, Timestamp: now})
+	maxCount := port.MaxPersistenceCount
+	if maxCount <= 0 {
+		maxCount = 1
+	}
+	if len(saved.Values) > maxCount {
+		saved.Values = saved.Values[len(saved.Values)-maxCount:]
+	}
+	body, err := json.Marshal(saved)
+	if err != nil {
+		return fmt.Errorf("persist object: %s port: %s err: %v", objectUUID, port.GetID(), err)
+	}
+	err = db.SaveRows(PortValuesTable, []*storage.Row{{Key: key, Group: objectUUID, Value: string(body), Time: now}})
+	if err != nil {
+		return fmt.Errorf("persist object: %s port: %s err: %v", objectUUID, port.GetID(), err)
+	}
+	p.mutex.Lock()
+	p.last[key] = value
+	p.mutex.Unlock()
+	return nil
+}
+
+func (p *portPersistence) Values(objectUUID, portID string) ([]*PersistedPortValue, error) {
+	db, err := p.storage()
+	if err != nil {
+		return nil, err
+	}
+	row, err := db.GetRow(PortValuesTable, portValueKey(objectUUID, portID))
+	if err != nil || row == nil {
+		return nil, err
+	}
+	saved, err := decodePersistedPort(row)
+	if err != nil {
+		return nil, err
+	}
+	return saved.Values, nil
+}
+
+func (p *portPersistence) Latest(objectUUID string) ([]*ObjectPersistenceValue, error) {
+	db, err := p.storage()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.RowsByGroup(PortValuesTable, objectUUID)
+	if err != nil {
+		return nil, err
+	}
+	var out []*ObjectPersistenceValue
+	for _, row := range rows {
+		saved, err := decodePersistedPort(row)
+		if err != nil {
+			return nil, err
+		}
+		if len(saved.Values) > 0 {
+			out = append(out, saved.Values[len(saved.Values)-1].ObjectPersistenceValue)
+		}
+	}
+	return out, nil
+}
+
+func (p *portPersistence) Start() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.stop != nil {
+		return
+	}
+	stop := make(chan struct{})
+	p.stop = stop
+	go func() {
+		ticker := time.NewTicker(p.opts.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				for _, err := range p.Snapshot() {
+					log.Printf("port persistence: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (p *portPersistence) Stop() {
+	p.mutex.Lock()
+	if p.stop != nil {
+		close(p.stop)
+		p.stop = nil
+	}
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	p.mutex.Unlock()
+	p.flush()
+}
+
+func decodePersistedPort(row *storage.Row) (*persistedPort, error) {
+	var saved *persistedPort
+	if err := json.Unmarshal([]byte(row.Value), &saved); err != nil {
+		return nil, fmt.Errorf("persisted value: %s err: %v", row.Key, err)
+	}
+	if saved == nil {
+		return nil, fmt.Errorf("persisted value: %s err: the value is empty", row.Key)
+	}
+	return saved, nil
+}
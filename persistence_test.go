@@ -0,0 +1,159 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/libs/nils"
+	"github.com/NubeIO/rxlib/libs/storage"
+	"github.com/NubeIO/rxlib/payload"
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"testing"
+	"time"
+)
+
+// persistedObject only implements what the port persistence needs
+type persistedObject struct {
+	testObject
+	ports    []*Port
+	restored []*ObjectPersistenceValue
+}
+
+func (o *persistedObject) PortsWithPersistenceEnabled() []*Port { return o.ports }
+func (o *persistedObject) GetPortValue(portID string) *runtime.PortValue {
+	for _, port := range o.ports {
+		if port.ID == portID {
+			return port.GetPayload().PortValue
+		}
+	}
+	return nil
+}
+func (o *persistedObject) RestorePersistedValues(value *ObjectPersistenceValue) error {
+	o.restored = append(o.restored, value)
+	return nil
+}
+
+func newPersistedFloatPort(id string, maxCount int) *Port {
+	return &Port{
+		ID:                  id,
+		DataType:            priority.TypeFloat,
+		EnablePersistence:   true,
+		MaxPersistenceCount: maxCount,
+		Payload:             &payload.Payload{PortValue: &runtime.PortValue{}},
+	}
+}
+
+func TestPortPersistence(t *testing.T) {
+	db, err := storage.NewMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	port := newPersistedFloatPort("setpoint", 2)
+	obj := &persistedObject{testObject: testObject{uuid: "abc"}, ports: []*Port{port}}
+	r := &RuntimeImpl{storage: db}
+	r.AddObjects([]Object{obj})
+	r.persistence = newPortPersistence(r, &PersistenceOpts{Debounce: 10 * time.Millisecond})
+
+	for _, v := range []float64{20, 21, 21, 22} {
+		port.SetValueFloat(v)
+		if errs := r.Persistence().Snapshot(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+	}
+	values, err := r.Persistence().Values("abc", "setpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 {
+		t.Fatalf("expected MaxPersistenceCount of 2 values got: %d", len(values))
+	}
+	if nils.GetFloat64(values[0].ValueFloat) != 21 || nils.GetFloat64(values[1].ValueFloat) != 22 {
+		t.Fatalf("expected the last 2 values to be kept got: %v %v", *values[0].ValueFloat, *values[1].ValueFloat)
+	}
+
+	// debounce
+	port.SetValueFloat(23)
+	r.Persistence().PortUpdated("abc", "setpoint")
+	time.Sleep(100 * time.Millisecond)
+	latest, err := r.GetPersistedValues("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(latest) != 1 || nils.GetFloat64(latest[0].ValueFloat) != 23 {
+		t.Fatalf("expected the debounced value to be saved got: %+v", latest)
+	}
+
+	// restart; a new runtime on the same storage restores the last value
+	restarted := &persistedObject{testObject: testObject{uuid: "abc"}, ports: []*Port{newPersistedFloatPort("setpoint", 2)}}
+	r2 := &RuntimeImpl{storage: db}
+	r2.AddObjects([]Object{restarted})
+	r2.persistence = newPortPersistence(r2, nil)
+	if errs := r2.Persistence().Restore(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if len(restarted.restored) != 1 || nils.GetFloat64(restarted.restored[0].ValueFloat) != 23 {
+		t.Fatalf("expected the value to be restored got: %+v", restarted.restored)
+	}
+
+	// an object added after boot is restored when it is added
+	added := &persistedObject{testObject: testObject{uuid: "abc"}, ports: []*Port{newPersistedFloatPort("setpoint", 2)}}
+	r3 := &RuntimeImpl{storage: db}
+	r3.persistence = newPortPersistence(r3, nil)
+	r3.AddObject(added)
+	if len(added.restored) != 1 || nils.GetFloat64(added.restored[0].ValueFloat) != 23 {
+		t.Fatalf("expected the added object to be restored got: %+v", added.restored)
+	}
+
+	// a stored null is an error, not a nil value
+	if err := db.SaveRows(PortValuesTable, []*storage.Row{{Key: portValueKey("abc", "empty"), Group: "abc", Value: "null", Time: time.Now()}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r3.Persistence().Values("abc", "empty"); err == nil {
+		t.Fatal("expected a stored null to fail to decode")
+	}
+	if errs := r3.Persistence().RestoreObject(added); len(errs) != 1 {
+		t.Fatalf("expected the null value to be the only restore error got: %v", errs)
+	}
+}
+
+func TestBaseObjectPersistsWrites(t *testing.T) {
+	db, err := storage.NewMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	a := newBaseAdd("a", newTestBus())
+	setpoint := NewPortFloat("setpoint")
+	setpoint.EnablePersistence = true
+	_ = a.NewOutputPort(setpoint)
+	r := &RuntimeImpl{storage: db}
+	r.AddObjects([]Object{a})
+	r.persistence = newPortPersistence(r, &PersistenceOpts{Debounce: 10 * time.Millisecond})
+	r.overrides = newOverrideManager(r, nil)
+
+	saved := func(v float64) func() bool {
+		return func() bool {
+			values, _ := r.Persistence().Values("a", "setpoint")
+			return len(values) > 0 && nils.GetFloat64(values[len(values)-1].ValueFloat) == v
+		}
+	}
+	// the write is saved after the debounce, without waiting for the snapshot interval
+	_ = a.SetOutput("setpoint", 21.0)
+	waitFor(t, saved(21))
+	_ = a.OverrideValue(30.0, "setpoint")
+	waitFor(t, saved(30))
+	_ = a.ReleaseOverride("setpoint")
+	waitFor(t, saved(21))
+
+	// the snapshot reads the values through the object while it is being written, run with -race
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = a.SetOutput("setpoint", float64(i))
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		r.Persistence().Snapshot()
+	}
+	<-done
+}
@@ -0,0 +1,203 @@
+package rxlib
+
+import (
+	"fmt"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// FieldChange is a difference between the running and desired config, From is nil when added and To is nil when removed
+type FieldChange struct {
+	Field string `json:"field"` // eg; settings, meta.parentUUID, inputs.in-1.dataType, connections.<connectionUUID>
+	From  any    `json:"from,omitempty"`
+	To    any    `json:"to,omitempty"`
+}
+
+type ObjectPlan struct {
+	ObjectUUID string                `json:"objectUUID"`
+	ObjectID   string                `json:"objectID"`
+	Name       string                `json:"name,omitempty"`
+	Action     DeployAction          `json:"action"`
+	Changes    []*FieldChange        `json:"changes,omitempty"`
+	Config     *runtime.ObjectConfig `json:"-"` // the desired config, nil when removed
+}
+
+// DeployPlan is what a deploy of the desired configs would change, use ToDeploy() to deploy it
+type DeployPlan struct {
+	Added   []*ObjectPlan `json:"added"`
+	Removed []*ObjectPlan `json:"removed"`
+	Updated []*ObjectPlan `json:"updated"`
+}
+
+// HasChanges returns false if the desired configs are the same as the running objects
+func (p *DeployPlan) HasChanges() bool {
+	return len(p.Added) > 0 || len(p.Removed) > 0 || len(p.Updated) > 0
+}
+
+// ToDeploy converts the plan to the Deploy body
+func (p *DeployPlan) ToDeploy() *Deploy {
+	d := &Deploy{}
+	for _, o := range p.Added {
+		d.New = append(d.New, o.Config)
+	}
+	for _, o := range p.Updated {
+		d.Updated = append(d.Updated, o.Config)
+	}
+	for _, o := range p.Removed {
+		d.Deleted = append(d.Deleted, o.ObjectUUID)
+	}
+	return d
+}
+
+// Plan compares the full desired set of objects with the running objects, nothing is changed
+func (inst *RuntimeImpl) Plan(desired []*runtime.ObjectConfig) (*DeployPlan, error) {
+	return DiffObjectConfigs(inst.GetObjectsConfig(), desired)
+}
+
+// DiffObjectConfigs returns the plan to go from the current to the desired configs; the objects are matched by uuid
+func DiffObjectConfigs(current, desired []*runtime.ObjectConfig) (*DeployPlan, error) {
+	existing := make(map[string]*runtime.ObjectConfig, len(current))
+	for _, config := range current {
+		existing[config.GetMeta().GetObjectUUID()] = config
+	}
+	plan := &DeployPlan{}
+	wanted := make(map[string]bool, len(desired))
+	for _, config := range desired {
+		if err := validateObjectConfig(config); err != nil {
+			return nil, err
+		}
+		uuid := config.GetMeta().GetObjectUUID()
+		if wanted[uuid] {
+			return nil, fmt.Errorf("object with uuid: %s is in the desired configs more than once", uuid)
+		}
+		wanted[uuid] = true
+		o := &ObjectPlan{
+			ObjectUUID: uuid,
+			ObjectID:   config.GetId(),
+			Name:       config.GetMeta().GetObjectName(),
+			Config:     config,
+		}
+		was, ok := existing[uuid]
+		if !ok {
+			o.Action = DeployNew
+			plan.Added = append(plan.Added, o)
+			continue
+		}
+		if was.GetId() != config.GetId() {
+			return nil, fmt.Errorf("object with uuid: %s id can't be changed from: %s to: %s", uuid, was.GetId(), config.GetId())
+		}
+		o.Changes = diffObjectConfig(was, config)
+		if len(o.Changes) > 0 {
+			o.Action = DeployUpdated
+			plan.Updated = append(plan.Updated, o)
+		}
+	}
+	for _, config := range current {
+		uuid := config.GetMeta().GetObjectUUID()
+		if !wanted[uuid] {
+			plan.Removed = append(plan.Removed, &ObjectPlan{
+				ObjectUUID: uuid,
+				ObjectID:   config.GetId(),
+				Name:       config.GetMeta().GetObjectName(),
+				Action:     DeployDeleted,
+			})
+		}
+	}
+	return plan, nil
+}
+
+func diffObjectConfig(was, config *runtime.ObjectConfig) []*FieldChange {
+	var changes []*FieldChange
+	change := func(field string, from, to any) {
+		changes = append(changes, &FieldChange{Field: field, From: from, To: to})
+	}
+	if from, to := was.GetMeta().GetObjectName(), config.GetMeta().GetObjectName(); from != to {
+		change("meta.objectName", from, to)
+	}
+	if from, to := was.GetMeta().GetParentUUID(), config.GetMeta().GetParentUUID(); from != to {
+		change("meta.parentUUID", from, to)
+	}
+	if from, to := was.GetMeta().GetPosition(), config.GetMeta().GetPosition(); !proto.Equal(from, to) {
+		change("meta.position", from, to)
+	}
+	if from, to := was.GetSettings().GetValue(), config.GetSettings().GetValue(); from != to {
+		change("settings", from, to)
+	}
+	changes = append(changes, diffPorts("inputs", was.GetInputs(), config.GetInputs())...)
+	changes = append(changes, diffPorts("outputs", was.GetOutputs(), config.GetOutputs())...)
+	changes = append(changes, diffConnections(was.GetConnections(), config.GetConnections())...)
+	return changes
+}
+
+func diffPorts(field string, was, ports []*runtime.Port) []*FieldChange {
+	var changes []*FieldChange
+	existing := make(map[string]*runtime.Port, len(was))
+	for _, port := range was {
+		existing[port.GetId()] = port
+	}
+	wanted := make(map[string]bool, len(ports))
+	for _, port := range ports {
+		wanted[port.GetId()] = true
+		name := fmt.Sprintf("%s.%s", field, port.GetId())
+		old, ok := existing[port.GetId()]
+		if !ok {
+			changes = append(changes, &FieldChange{Field: name, To: port})
+			continue
+		}
+		if old.GetName() != port.GetName() {
+			changes = append(changes, &FieldChange{Field: name + ".name", From: old.GetName(), To: port.GetName()})
+		}
+		if old.GetDataType() != port.GetDataType() {
+			changes = append(changes, &FieldChange{Field: name + ".dataType", From: old.GetDataType(), To: port.GetDataType()})
+		}
+		if old.GetDefaultPosition() != port.GetDefaultPosition() {
+			changes = append(changes, &FieldChange{Field: name + ".defaultPosition", From: old.GetDefaultPosition(), To: port.GetDefaultPosition()})
+		}
+		if !proto.Equal(old.GetTransformation(), port.GetTransformation()) {
+			changes = append(changes, &FieldChange{Field: name + ".transformation", From: old.GetTransformation().AsMap(), To: port.GetTransformation().AsMap()})
+		}
+	}
+	for _, port := range was {
+		if !wanted[port.GetId()] {
+			changes = append(changes, &FieldChange{Field: fmt.Sprintf("%s.%s", field, port.GetId()), From: port})
+		}
+	}
+	return changes
+}
+
+func diffConnections(was, connections []*runtime.Connection) []*FieldChange {
+	var changes []*FieldChange
+	existing := make(map[string]*runtime.Connection, len(was))
+	for _, connection := range was {
+		existing[connection.GetConnectionUUID()] = connection
+	}
+	wanted := make(map[string]bool, len(connections))
+	for _, connection := range connections {
+		wanted[connection.GetConnectionUUID()] = true
+		name := "connections." + connection.GetConnectionUUID()
+		old, ok := existing[connection.GetConnectionUUID()]
+		switch {
+		case !ok:
+			changes = append(changes, &FieldChange{Field: name, To: connection})
+		case !sameConnection(old, connection):
+			changes = append(changes, &FieldChange{Field: name, From: old, To: connection})
+		}
+	}
+	for _, connection := range was {
+		if !wanted[connection.GetConnectionUUID()] {
+			changes = append(changes, &FieldChange{Field: "connections." + connection.GetConnectionUUID(), From: connection})
+		}
+	}
+	return changes
+}
+
+// sameConnection compares the wiring of the connections, the status fields like the last ok time are left out
+func sameConnection(a, b *runtime.Connection) bool {
+	return a.GetSourceUUID() == b.GetSourceUUID() &&
+		a.GetSourcePort() == b.GetSourcePort() &&
+		a.GetTargetUUID() == b.GetTargetUUID() &&
+		a.GetTargetPort() == b.GetTargetPort() &&
+		a.GetTargetConnectionUUID() == b.GetTargetConnectionUUID() &&
+		a.GetFlowDirection() == b.GetFlowDirection() &&
+		a.GetDisable() == b.GetDisable()
+}
@@ -0,0 +1,141 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/types/known/structpb"
+	"testing"
+)
+
+func TestDiffObjectConfigs(t *testing.T) {
+	transformation, err := structpb.NewStruct(map[string]any{"scale": 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	publisher, _ := NewConnection("a", "out", "b", "in")
+	current := []*runtime.ObjectConfig{
+		{
+			Id:          "add",
+			Meta:        &runtime.Meta{ObjectUUID: "a", ObjectName: "add"},
+			Settings:    &runtime.ObjectSettings{Value: "{}"},
+			Inputs:      []*runtime.Port{{Id: "in-1", DataType: "float"}, {Id: "in-2", DataType: "float"}},
+			Connections: []*runtime.Connection{publisher},
+		},
+		{Id: "add", Meta: &runtime.Meta{ObjectUUID: "b"}},
+		{Id: "add", Meta: &runtime.Meta{ObjectUUID: "c"}},
+	}
+	changed := &runtime.Connection{ConnectionUUID: publisher.ConnectionUUID, SourceUUID: "a", SourcePort: "out", TargetUUID: "b", TargetPort: "in", Disable: true, FlowDirection: DirectionPublisher, TargetConnectionUUID: publisher.TargetConnectionUUID}
+	desired := []*runtime.ObjectConfig{
+		{
+			Id:          "add",
+			Meta:        &runtime.Meta{ObjectUUID: "a", ObjectName: "sum"},
+			Settings:    &runtime.ObjectSettings{Value: `{"x":1}`},
+			Inputs:      []*runtime.Port{{Id: "in-1", DataType: "bool", Transformation: transformation}, {Id: "in-3"}},
+			Connections: []*runtime.Connection{changed},
+		},
+		{Id: "add", Meta: &runtime.Meta{ObjectUUID: "b"}},
+		{Id: "sub", Meta: &runtime.Meta{ObjectUUID: "d", ParentUUID: "a"}},
+	}
+	plan, err := DiffObjectConfigs(current, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.HasChanges() || len(plan.Added) != 1 || len(plan.Removed) != 1 || len(plan.Updated) != 1 {
+		t.Fatalf("unexpected plan: %+v", plan)
+	}
+	if plan.Added[0].ObjectUUID != "d" || plan.Removed[0].ObjectUUID != "c" || plan.Updated[0].ObjectUUID != "a" {
+		t.Fatalf("unexpected plan: %+v", plan)
+	}
+	expected := []string{"meta.objectName", "settings", "inputs.in-1.dataType", "inputs.in-1.transformation", "inputs.in-3", "inputs.in-2", "connections." + publisher.ConnectionUUID}
+	changes := plan.Updated[0].Changes
+	if len(changes) != len(expected) {
+		t.Fatalf("expected changes %v got %d", expected, len(changes))
+	}
+	for i, field := range expected {
+		if changes[i].Field != field {
+			t.Fatalf("expected change %s got %s", field, changes[i].Field)
+		}
+	}
+	if changes[4].From != nil || changes[5].To != nil {
+		t.Fatal("expected an added and a removed port")
+	}
+
+	d := plan.ToDeploy()
+	if len(d.New) != 1 || d.New[0] != desired[2] || len(d.Updated) != 1 || d.Updated[0] != desired[0] || len(d.Deleted) != 1 || d.Deleted[0] != "c" {
+		t.Fatalf("unexpected deploy: %+v", d)
+	}
+
+	if _, err := DiffObjectConfigs(current, append(desired, desired[0])); err == nil {
+		t.Fatal("expected an err for a duplicate uuid")
+	}
+	if _, err := DiffObjectConfigs(current, []*runtime.ObjectConfig{{Id: "sub", Meta: &runtime.Meta{ObjectUUID: "a"}}}); err == nil {
+		t.Fatal("expected an err for a changed object id")
+	}
+}
+
+func TestPlanDeploy(t *testing.T) {
+	r, _ := newDeployRuntime()
+	desired := r.GetObjectsConfig()
+	desired[0].Settings = &runtime.ObjectSettings{Value: "b"}
+	desired = append(desired[:1], deployConfig("add", "add", "", ""))
+
+	plan, err := r.Plan(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Added) != 1 || len(plan.Updated) != 1 || len(plan.Removed) != 2 {
+		t.Fatalf("unexpected plan: %+v", plan)
+	}
+	if resp := r.Deploy(plan.ToDeploy()); !resp.Ok {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	plan, err = r.Plan(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.HasChanges() {
+		t.Fatalf("expected no changes after the deploy: %+v", plan)
+	}
+}
+
+func TestPlanDeployTransformation(t *testing.T) {
+	a := newBaseAdd("a", newTestBus())
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{a})
+	transformation, err := priority.ToProtoStruct(&priority.Transformations{EnableTransformation: true, OverridePort: true, OverridePortValue: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	desired := r.GetObjectsConfig()
+	desired[0].Inputs[0].Transformation = transformation
+
+	plan, err := r.Plan(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Updated) != 1 {
+		t.Fatalf("unexpected plan: %+v", plan)
+	}
+	if resp := r.Deploy(plan.ToDeploy()); !resp.Ok {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if got := a.GetInput("in").GetTransformation(); got == nil || !got.OverridePort || got.OverridePortValue != 5 {
+		t.Fatalf("expected the transformation to be deployed, got: %+v", got)
+	}
+	if plan, _ = r.Plan(desired); plan.HasChanges() {
+		t.Fatalf("expected no changes after the deploy: %+v", plan)
+	}
+
+	// the ports are built by the object, a port change is rejected
+	desired = r.GetObjectsConfig()
+	desired[0].Inputs[0].DataType = string(priority.TypeBool)
+	desired[0].Inputs[0].Transformation = nil
+	plan, _ = r.Plan(desired)
+	resp := r.Deploy(plan.ToDeploy())
+	if resp.Ok || len(resp.Results) != 1 || resp.Results[0].Error == "" {
+		t.Fatalf("expected the port change to fail: %+v", resp)
+	}
+	if a.GetInput("in").GetTransformation() == nil {
+		t.Fatal("expected the failed deploy to keep the transformation")
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/NubeIO/rxlib/libs/nils"
 	"github.com/NubeIO/rxlib/payload"
 	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
 	"github.com/NubeIO/rxlib/unitswrapper"
+	"google.golang.org/protobuf/proto"
 	"time"
 )
 
@@ -26,6 +28,7 @@ type Port struct {
 	DataType              priority.Type // float, bool, string, any, json
 	DisableSubscription   bool          // if set to true we will not set up connection as a subscriber; this would be used when a connection is used to maybe pull the data on an interval
 	OnlyPublishOnCOV      bool
+	Publish               *PublishOpts // the COV increment, publish intervals, heartbeat and debounce of an output
 
 	AllowMultipleConnections bool
 	HasConnection            bool
@@ -39,11 +42,16 @@ type Port struct {
 	LastFail    *time.Time
 	FailMessage string
 
+	// MaxAge if set the value is stale when its source timestamp is older, see GetQuality()
+	MaxAge time.Duration
+
 	// stored in db
 	EnablePersistence   bool
 	MaxPersistenceCount int
 
 	OnMessage func(portID string, msg *payload.Payload) // used for the evntbus
+
+	overridden *runtime.PortValue // the value before the override, restored on Release()
 }
 
 func (p *Port) GetID() string {
@@ -217,7 +225,15 @@ func (p *Port) GetTransformationDisplayValue() (value string, isNil bool) {
 	return nils.GetString(v), false
 }
 
+// Release restores the value the port had before the override
 func (p *Port) Release() {
+	if p.overridden != nil {
+		p.GetPayload().PortValue = p.overridden
+		p.GetPayload().UnsetTransformationExistingValueFloat()
+		p.overridden = nil
+		p.OverrideApplied = false
+		return
+	}
 	v, isNil := p.GetPayload().GetTransformationExistingValueFloat()
 	if isNil {
 		p.GetPayload().FloatValue = nil
@@ -228,10 +244,42 @@ func (p *Port) Release() {
 	p.OverrideApplied = false
 }
 
+// SetOverride sets the value until Release(), the value before the first override is kept for every data type
 func (p *Port) SetOverride(v interface{}) error {
 	if p == nil {
 		return errors.New("cannot override nil port")
 	}
+	if p.Payload == nil {
+		p.Payload = &payload.Payload{}
+	}
+	if p.Payload.PortValue == nil {
+		p.Payload.PortValue = &runtime.PortValue{PortID: p.ID, DataType: string(p.DataType), IsNil: true}
+	}
+	var previous *runtime.PortValue
+	if !p.OverrideApplied {
+		previous = proto.Clone(p.Payload.PortValue).(*runtime.PortValue)
+	}
+	if err := p.setOverride(v); err != nil {
+		return err
+	}
+	if previous != nil {
+		p.overridden = previous
+	}
+	p.Payload.IsNil = false
+	p.Payload.SetValueQuality(payload.QualityOverridden).SetSourceTime(time.Now())
+	return nil
+}
+
+// holdOverride keeps the value to restore on Release() while the port is overridden, it is false when the port is not overridden
+func (p *Port) holdOverride(value *runtime.PortValue) bool {
+	if !p.OverrideApplied {
+		return false
+	}
+	p.overridden = value
+	return true
+}
+
+func (p *Port) setOverride(v interface{}) error {
 	dataType := p.GetDataType()
 	if dataType == "" {
 		return errors.New("data type was empty")
@@ -350,6 +398,65 @@ func (p *Port) SubscriptionDisabled() bool {
 	return false
 }
 
+// QualityReporter can be implemented by an object to set its outputs with a quality and to report the quality of its inputs, BaseObject implements it
+type QualityReporter interface {
+	// SetOutputWithQuality eg; SetOutputWithQuality("out", nil, payload.QualityCommFault, lastRead) when a device is offline
+	SetOutputWithQuality(portID string, value any, quality payload.Quality, sourceTime time.Time) error
+	// InputsQuality the worst quality and oldest source time of the inputs; eg q, t := InputsQuality("in-1", "in-2")
+	InputsQuality(portIDs ...string) (payload.Quality, time.Time)
+}
+
+// GetQuality returns the quality of the value; overridden when an override is applied, stale when the value is older than
+// the MaxAge, and uncertain when the port has no value yet
+func (p *Port) GetQuality() payload.Quality {
+	return p.qualityAt(time.Now())
+}
+
+func (p *Port) qualityAt(now time.Time) payload.Quality {
+	if p == nil || p.GetPayload() == nil || p.GetPayload().PortValue == nil {
+		return payload.QualityUncertain
+	}
+	if p.OverrideApplied {
+		return payload.QualityOverridden
+	}
+	quality := p.GetPayload().ValueQuality()
+	if quality == payload.QualityGood || quality == payload.QualityUncertain {
+		if p.isStale(now) {
+			return payload.QualityStale
+		}
+	}
+	return quality
+}
+
+// GetSourceTime is when the value was read or calculated at its source
+func (p *Port) GetSourceTime() time.Time {
+	return p.GetPayload().SourceTime()
+}
+
+// IsStale is if the port has a MaxAge and its value is older
+func (p *Port) IsStale() bool {
+	return p.isStale(time.Now())
+}
+
+func (p *Port) isStale(now time.Time) bool {
+	if p == nil || p.MaxAge <= 0 {
+		return false
+	}
+	t := p.GetSourceTime()
+	if t.IsZero() {
+		if p.GetPayload() == nil {
+			return false
+		}
+		t = p.GetPayload().Timestamp
+	}
+	return !t.IsZero() && now.Sub(t) > p.MaxAge
+}
+
+// IsTrusted see payload.Quality.IsTrusted()
+func (p *Port) IsTrusted() bool {
+	return p.GetQuality().IsTrusted()
+}
+
 type PersistenceValue struct {
 	MaxCount int `json:"maxCount"`
 }
@@ -360,6 +467,12 @@ type PortOpts struct {
 	AllowMultipleConnections bool `json:"allowMultipleConnections,omitempty"`
 	EnablePersistence        bool `json:"enablePersistence"`
 	MaxPersistenceCount      int  `json:"maxPersistenceCount"`
+	// MaxAge if set the value is stale when it is older
+	MaxAge time.Duration `json:"maxAge,omitempty"`
+	// OnlyPublishOnCOV an output is only published when its value changes
+	OnlyPublishOnCOV bool `json:"onlyPublishOnCOV,omitempty"`
+	// Publish throttles how often SetOutput() publishes an output, see PublishOpts
+	Publish *PublishOpts `json:"publish,omitempty"`
 }
 
 func portOpts(opts ...*PortOpts) *PortOpts {
@@ -380,6 +493,9 @@ func newPort(id string, dataType priority.Type, f func(portID string, message *p
 		AllowMultipleConnections: pOpts.AllowMultipleConnections,
 		EnablePersistence:        pOpts.EnablePersistence,
 		MaxPersistenceCount:      pOpts.MaxPersistenceCount,
+		MaxAge:                   pOpts.MaxAge,
+		OnlyPublishOnCOV:         pOpts.OnlyPublishOnCOV,
+		Publish:                  pOpts.Publish,
 		OnMessage:                f,
 	}
 	return p
@@ -430,6 +546,9 @@ type NewPort struct {
 	HiddenByDefault          bool
 	EnablePersistence        bool
 	MaxPersistenceCount      int
+	MaxAge                   time.Duration
+	OnlyPublishOnCOV         bool
+	Publish                  *PublishOpts
 	OnMessage                func(portID string, msg *payload.Payload) `json:"-"`
 }
 
@@ -454,6 +573,36 @@ type ObjectPersistenceValue struct {
 	ValueString *string
 }
 
+// NewObjectPersistenceValue takes a copy of the value of the port, the value is read from the object so it is taken with the
+// object lock held; eg NewObjectPersistenceValue(port, object.GetPortValue(port.GetID()))
+func NewObjectPersistenceValue(port *Port, value *runtime.PortValue) *ObjectPersistenceValue {
+	if port == nil {
+		return nil
+	}
+	out := &ObjectPersistenceValue{
+		PortID:    port.GetID(),
+		ValueType: string(port.GetDataType()),
+	}
+	if value == nil {
+		return out
+	}
+	switch port.GetDataType() {
+	case priority.TypeFloat:
+		out.ValueFloat = nils.Copy(value.FloatValue)
+	case priority.TypeInt:
+		if value.IntValue != nil {
+			out.ValueInt = nils.ToInt(int(*value.IntValue))
+		}
+	case priority.TypeBool:
+		out.ValueBool = nils.Copy(value.BoolValue)
+	case priority.TypeJSON:
+		out.ValueString = nils.Copy(value.JsonValue)
+	default:
+		out.ValueString = nils.Copy(value.StringValue)
+	}
+	return out
+}
+
 // some commonly used output names
 const (
 	OutputName        string = "output"
@@ -1,18 +1,295 @@
 package rxlib
 
-import "time"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/libs/history"
+	"github.com/NubeIO/rxlib/libs/storage"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	ObjectsSyncTable    = "ros_objects"
+	HistoriesSyncTable  = "ros_histories"
+	defaultSyncDuration = time.Minute
+)
 
 type SyncOptions struct {
 	AutoSync bool
 	Duration time.Duration
 }
 
+// dbSync keeps track of what has already been sent to the db, so each sync only sends what has changed
+type dbSync struct {
+	mutex       sync.Mutex
+	loopMutex   sync.Mutex
+	objects     map[string]string // object uuid -> last synced config
+	seeded      bool              // objects has been loaded from the db rows, so a restart only syncs what changed
+	histories   map[string]*historySyncState
+	objectsStop chan struct{}
+	historyStop chan struct{}
+}
+
+// historySyncRecord is the value stored for each history record
+type historySyncRecord struct {
+	ObjectUUID string `json:"objectUUID"`
+	Value      any    `json:"value"`
+}
+
+type historySyncState struct {
+	lastRecordUUID string
+	lastTimestamp  time.Time
+}
+
+func newDBSync() *dbSync {
+	return &dbSync{
+		objects:   make(map[string]string),
+		histories: make(map[string]*historySyncState),
+	}
+}
+
 func (inst *RuntimeImpl) ObjectSync(forceSync bool, opts *SyncOptions) error {
-	//TODO implement me
-	panic("implement me")
+	if err := inst.syncObjects(forceSync); err != nil {
+		return err
+	}
+	if opts != nil && opts.AutoSync {
+		inst.dbSync.startLoop(&inst.dbSync.objectsStop, opts.Duration, func() {
+			if err := inst.syncObjects(false); err != nil {
+				log.Printf("runtime object sync: %v", err)
+			}
+		})
+	}
+	return nil
 }
 
 func (inst *RuntimeImpl) HistorySync(forceSync bool, opts *SyncOptions) error {
-	//TODO implement me
-	panic("implement me")
+	if err := inst.syncHistories(forceSync); err != nil {
+		return err
+	}
+	if opts != nil && opts.AutoSync {
+		inst.dbSync.startLoop(&inst.dbSync.historyStop, opts.Duration, func() {
+			if err := inst.syncHistories(false); err != nil {
+				log.Printf("runtime history sync: %v", err)
+			}
+		})
+	}
+	return nil
+}
+
+func (inst *RuntimeImpl) syncObjects(forceSync bool) error {
+	s := inst.dbSync
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	db := inst.Storage()
+	if db == nil {
+		return errors.New("runtime storage is empty")
+	}
+	if !s.seeded {
+		if err := s.seedObjects(db); err != nil {
+			return fmt.Errorf("object sync load: %v", err)
+		}
+	}
+
+	var rows []*storage.Row
+	synced := make(map[string]string)
+	now := time.Now()
+	for _, config := range inst.SerializeObjects(false, inst.Get()) {
+		uuid := config.GetMeta().GetObjectUUID()
+		if uuid == "" {
+			continue
+		}
+		body, err := objectSyncBody(config)
+		if err != nil {
+			return fmt.Errorf("object sync uuid: %s err: %v", uuid, err)
+		}
+		synced[uuid] = body
+		if !forceSync && s.objects[uuid] == body {
+			continue
+		}
+		rows = append(rows, &storage.Row{
+			Key:   uuid,
+			Group: config.GetMeta().GetParentUUID(),
+			Value: body,
+			Time:  now,
+		})
+	}
+	var deleted []string
+	for uuid := range s.objects {
+		if _, ok := synced[uuid]; !ok {
+			deleted = append(deleted, uuid)
+		}
+	}
+
+	if err := db.SaveRows(ObjectsSyncTable, rows); err != nil {
+		return fmt.Errorf("object sync: %v", err)
+	}
+	if err := db.DeleteRows(ObjectsSyncTable, deleted); err != nil {
+		return fmt.Errorf("object sync delete: %v", err)
+	}
+	s.objects = synced
+	return nil
+}
+
+// seedObjects loads the rows of the last run, so the objects deleted while the runtime was offline are deleted from the
+// db and the unchanged objects are not written again
+func (s *dbSync) seedObjects(db storage.Storage) error {
+	rows, err := db.Rows(ObjectsSyncTable)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if _, ok := s.objects[row.Key]; ok {
+			continue
+		}
+		body, err := canonicalJSON([]byte(row.Value))
+		if err != nil {
+			body = row.Value // it is written again on the sync
+		}
+		s.objects[row.Key] = body
+	}
+	s.seeded = true
+	return nil
+}
+
+func (inst *RuntimeImpl) syncHistories(forceSync bool) error {
+	s := inst.dbSync
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	db := inst.Storage()
+	if db == nil {
+		return errors.New("runtime storage is empty")
+	}
+	if inst.hist == nil {
+		return errors.New("history manager is empty")
+	}
+
+	for _, hist := range inst.hist.All() {
+		state := s.histories[hist.GetUUID()]
+		if forceSync {
+			state = nil
+		}
+		records := unsyncedRecords(hist.GetRecords(), state)
+		if len(records) == 0 {
+			continue
+		}
+		var rows []*storage.Row
+		for _, record := range records {
+			value, err := json.Marshal(&historySyncRecord{
+				ObjectUUID: hist.GetObjectUUID(),
+				Value:      record.GetValue(),
+			})
+			if err != nil {
+				return fmt.Errorf("history sync uuid: %s err: %v", hist.GetUUID(), err)
+			}
+			rows = append(rows, &storage.Row{
+				Key:   record.GetUUID(),
+				Group: hist.GetUUID(),
+				Value: string(value),
+				Time:  record.GetTimestamp(),
+			})
+		}
+		if err := db.SaveRows(HistoriesSyncTable, rows); err != nil {
+			return fmt.Errorf("history sync: %v", err)
+		}
+		last := records[len(records)-1]
+		s.histories[hist.GetUUID()] = &historySyncState{
+			lastRecordUUID: last.GetUUID(),
+			lastTimestamp:  last.GetTimestamp(),
+		}
+	}
+	return nil
+}
+
+// unsyncedRecords returns the records added after the last sync. Records are appended in order, so we look for the
+// last synced record, and if it has been trimmed from the history we fall back to the timestamp
+func unsyncedRecords(records []history.Record, state *historySyncState) []history.Record {
+	if state == nil {
+		return records
+	}
+	for i, record := range records {
+		if record.GetUUID() == state.lastRecordUUID {
+			return records[i+1:]
+		}
+	}
+	var out []history.Record
+	for _, record := range records {
+		if record.GetTimestamp().After(state.lastTimestamp) {
+			out = append(out, record)
+		}
+	}
+	return out
+}
+
+// objectSyncBody the stats are left out as they change on every loop and are not part of the object config
+func objectSyncBody(config *runtime.ObjectConfig) (string, error) {
+	c := &runtime.ObjectConfig{
+		Id:          config.GetId(),
+		Info:        config.GetInfo(),
+		Inputs:      config.GetInputs(),
+		Outputs:     config.GetOutputs(),
+		Meta:        config.GetMeta(),
+		Connections: config.GetConnections(),
+		Settings:    config.GetSettings(),
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return canonicalJSON(b)
+}
+
+// canonicalJSON sorts the keys and drops the spaces, so a body read back from a postgres jsonb column matches the one sent
+func canonicalJSON(b []byte) (string, error) {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// stop the object and history sync loops
+func (s *dbSync) stop() {
+	s.loopMutex.Lock()
+	defer s.loopMutex.Unlock()
+	if s.objectsStop != nil {
+		close(s.objectsStop)
+		s.objectsStop = nil
+	}
+	if s.historyStop != nil {
+		close(s.historyStop)
+		s.historyStop = nil
+	}
+}
+
+func (s *dbSync) startLoop(stop *chan struct{}, duration time.Duration, sync func()) {
+	if duration <= 0 {
+		duration = defaultSyncDuration
+	}
+	s.loopMutex.Lock()
+	defer s.loopMutex.Unlock()
+	if *stop != nil {
+		close(*stop)
+	}
+	done := make(chan struct{})
+	*stop = done
+	go func() {
+		ticker := time.NewTicker(duration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				sync()
+			}
+		}
+	}()
 }
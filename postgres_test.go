@@ -0,0 +1,124 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/libs/history"
+	"github.com/NubeIO/rxlib/libs/storage"
+	"slices"
+	"testing"
+)
+
+// savedKeys records the keys of the rows saved to the storage
+type savedKeys struct {
+	storage.Storage
+	keys []string
+}
+
+func (s *savedKeys) SaveRows(table string, rows []*storage.Row) error {
+	for _, row := range rows {
+		s.keys = append(s.keys, row.Key)
+	}
+	return s.Storage.SaveRows(table, rows)
+}
+
+func TestObjectSync(t *testing.T) {
+	db, err := storage.NewMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	syncObjects := func(r *RuntimeImpl) []string {
+		t.Helper()
+		saved := &savedKeys{Storage: db}
+		r.storage = saved
+		if err := r.ObjectSync(false, nil); err != nil {
+			t.Fatal(err)
+		}
+		slices.Sort(saved.keys)
+		return saved.keys
+	}
+	storedKeys := func() []string {
+		t.Helper()
+		rows, err := db.Rows(ObjectsSyncTable)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, row := range rows {
+			keys = append(keys, row.Key)
+		}
+		slices.Sort(keys)
+		return keys
+	}
+
+	r, _ := newDeployRuntime()
+	r.dbSync = newDBSync()
+	if saved := syncObjects(r); !slices.Equal(saved, []string{"counter", "device", "network"}) {
+		t.Fatalf("unexpected saved objects: %v", saved)
+	}
+	// only the updated object is saved, and the deleted object is removed
+	r.GetByUUID("counter").(*deployObject).settings = "b"
+	if err := r.DeleteByUUID("device"); err != nil {
+		t.Fatal(err)
+	}
+	if saved := syncObjects(r); !slices.Equal(saved, []string{"counter"}) {
+		t.Fatalf("unexpected saved objects: %v", saved)
+	}
+	if keys := storedKeys(); !slices.Equal(keys, []string{"counter", "network"}) {
+		t.Fatalf("unexpected stored objects: %v", keys)
+	}
+
+	// after a restart the unchanged objects are not saved again, and the object deleted while offline is removed
+	restarted, _ := newDeployRuntime()
+	restarted.dbSync = newDBSync()
+	restarted.GetByUUID("counter").(*deployObject).settings = "b"
+	for _, uuid := range []string{"device", "network"} {
+		if err := restarted.DeleteByUUID(uuid); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if saved := syncObjects(restarted); len(saved) != 0 {
+		t.Fatalf("expected nothing to be saved after the restart got: %v", saved)
+	}
+	if keys := storedKeys(); !slices.Equal(keys, []string{"counter"}) {
+		t.Fatalf("unexpected stored objects: %v", keys)
+	}
+}
+
+func TestHistorySync(t *testing.T) {
+	db, err := storage.NewMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	r := &RuntimeImpl{
+		hist:    history.NewHistoryManager("test"),
+		storage: db,
+		dbSync:  newDBSync(),
+	}
+	h := r.hist.NewHistory(10, "abc")
+	h.AddRecord(history.NewGenericRecord(1.0))
+	h.AddRecord(history.NewGenericRecord(2.0))
+
+	if err := r.HistorySync(false, nil); err != nil {
+		t.Fatal(err)
+	}
+	rows, _ := db.RowsByGroup(HistoriesSyncTable, h.GetUUID())
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 records to be synced got: %d", len(rows))
+	}
+
+	h.AddRecord(history.NewGenericRecord(3.0))
+	if got := unsyncedRecords(h.GetRecords(), r.dbSync.histories[h.GetUUID()]); len(got) != 1 {
+		t.Fatalf("expected only the new record to be pending got: %d", len(got))
+	}
+	if err := r.HistorySync(false, nil); err != nil {
+		t.Fatal(err)
+	}
+	rows, _ = db.RowsByGroup(HistoriesSyncTable, h.GetUUID())
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 records to be synced got: %d", len(rows))
+	}
+	if got := unsyncedRecords(h.GetRecords(), r.dbSync.histories[h.GetUUID()]); len(got) != 0 {
+		t.Fatalf("expected nothing to be pending got: %d", len(got))
+	}
+}
@@ -237,13 +237,13 @@ func ToProtoStruct(t *Transformations) (*structpb.Struct, error) {
 		"overridePortValue":    t.OverridePortValue,
 		"enums":                enumsList,
 		"applyEnum":            t.ApplyEnum,
-		"fallBackValue":        nils.GetFloat64(t.FallBackValue),
+		"fallBackValue":        optional(t.FallBackValue),
 		"permitNull":           t.PermitNull,
-		"round":                nils.GetInt(t.Round),
+		"round":                optional(t.Round),
 		"applyMinMax":          t.ApplyMinMax,
 		"minMaxValue":          convertMinMaxValue(t.MinMaxValue),
 		"errorOnMinMax":        t.ErrorOnMinMax,
-		"restrictNumber":       nils.GetFloat64(t.RestrictNumber),
+		"restrictNumber":       optional(t.RestrictNumber),
 		"applyScale":           t.ApplyScale,
 		"scaleMinMaxValue":     convertScaleMinMaxValue(t.ScaleMinMaxValue),
 		"applyUnits":           t.ApplyUnits,
@@ -252,33 +252,44 @@ func ToProtoStruct(t *Transformations) (*structpb.Struct, error) {
 	return structpb.NewStruct(transformMap)
 }
 
+// optional returns nil for a nil pointer so it is kept as a null value, and not changed to the zero value
+func optional[T any](p *T) interface{} {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
 // Helper function to convert MinMaxValue to map
-func convertMinMaxValue(m *MinMaxValue) map[string]interface{} {
+func convertMinMaxValue(m *MinMaxValue) interface{} {
 	if m == nil {
 		return nil
 	}
 	return map[string]interface{}{
-		"minValue":    nils.GetFloat64(m.MinValue),
-		"maxValue":    nils.GetFloat64(m.MaxValue),
-		"minOutValue": nils.GetFloat64(m.MinOutValue),
-		"maxOutValue": nils.GetFloat64(m.MaxOutValue),
+		"minValue":    optional(m.MinValue),
+		"maxValue":    optional(m.MaxValue),
+		"minOutValue": optional(m.MinOutValue),
+		"maxOutValue": optional(m.MaxOutValue),
 	}
 }
 
 // Helper function to convert ScaleMinMaxValue to map
-func convertScaleMinMaxValue(s *ScaleMinMaxValue) map[string]interface{} {
+func convertScaleMinMaxValue(s *ScaleMinMaxValue) interface{} {
 	if s == nil {
 		return nil
 	}
 	return map[string]interface{}{
-		"minValue":    nils.GetFloat64(s.MinValue),
-		"maxValue":    nils.GetFloat64(s.MaxValue),
-		"minOutValue": nils.GetFloat64(s.MinOutValue),
-		"maxOutValue": nils.GetFloat64(s.MaxOutValue),
+		"minValue":    optional(s.MinValue),
+		"maxValue":    optional(s.MaxValue),
+		"minOutValue": optional(s.MinOutValue),
+		"maxOutValue": optional(s.MaxOutValue),
 	}
 }
 
-func convertEngineeringUnits(e *unitswrapper.Units) map[string]interface{} {
+func convertEngineeringUnits(e *unitswrapper.Units) interface{} {
+	if e == nil {
+		return nil
+	}
 	return map[string]interface{}{
 		"decimalPlaces": e.DecimalPlaces,
 		"unitCategory":  e.UnitCategory,
@@ -17,10 +17,15 @@ type Protocol interface {
 	Close() error
 	Ping(opts *Opts, callback func(string, *Message, error)) (string, error)
 	Command(opts *Opts, command *rxlib.ExtendedCommand, callback func(string, *runtime.CommandResponse, error)) (string, error)
+	// Watch calls the callback with the runtime changes after req.AfterSequence, until the returned stop func is called; a reset event means the client must reload the objects
+	Watch(opts *Opts, req *runtime.WatchRequest, callback func(*runtime.ChangeEvent, error)) (func(), error)
 }
 
 const defaultTimeout = 2
 
+// watchRetry is how long a watch waits before it reconnects
+const watchRetry = 2 * time.Second
+
 type Callback struct {
 	UUID string
 	Body interface{}
@@ -84,6 +89,10 @@ func (m *Client) Ping(opts *Opts, callback func(string, *Message, error)) (strin
 	return m.protocol.Ping(opts, callback)
 }
 
+func (m *Client) Watch(opts *Opts, req *runtime.WatchRequest, callback func(*runtime.ChangeEvent, error)) (func(), error) {
+	return m.protocol.Watch(opts, req, callback)
+}
+
 func duration(timeout int32) time.Duration {
 	if timeout == 0 {
 		timeout = 1
@@ -7,6 +7,7 @@ import (
 	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"time"
 )
 
@@ -51,6 +52,44 @@ func (g *GRPCClient) ObjectsDeploy(object *rxlib.Deploy, opts *Opts, callback fu
 	return uuid, nil
 }
 
+// Watch streams the changes, after a disconnect it resumes after the sequence of the last event
+func (g *GRPCClient) Watch(opts *Opts, req *runtime.WatchRequest, callback func(*runtime.ChangeEvent, error)) (func(), error) {
+	if req == nil {
+		req = &runtime.WatchRequest{}
+	}
+	req, _ = proto.Clone(req).(*runtime.WatchRequest)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for ctx.Err() == nil {
+			err := g.watch(ctx, req, callback)
+			if ctx.Err() != nil {
+				return
+			}
+			callback(nil, fmt.Errorf("watch changes: %v", err))
+			select {
+			case <-ctx.Done():
+			case <-time.After(watchRetry):
+			}
+		}
+	}()
+	return cancel, nil
+}
+
+func (g *GRPCClient) watch(ctx context.Context, req *runtime.WatchRequest, callback func(*runtime.ChangeEvent, error)) error {
+	stream, err := g.client.WatchChanges(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		req.AfterSequence = event.GetSequence()
+		callback(event, nil)
+	}
+}
+
 func (g *GRPCClient) Close() error {
 	return g.conn.Close()
 }
@@ -18,6 +18,10 @@ type HTTPClient struct {
 	baseURL string
 }
 
+func (h *HTTPClient) Watch(opts *Opts, req *runtime.WatchRequest, callback func(*runtime.ChangeEvent, error)) (func(), error) {
+	return nil, fmt.Errorf("watch is not supported over http, use grpc or mqtt")
+}
+
 type Response struct {
 	UUID string
 	Body interface{}
@@ -7,6 +7,8 @@ import (
 	"github.com/NubeIO/rxlib"
 	"github.com/NubeIO/rxlib/helpers"
 	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sync"
 	"time"
 )
 
@@ -228,3 +230,107 @@ func (m *MQTTClient) ObjectsDeploy(object *rxlib.Deploy, opts *Opts, callback fu
 
 	return "uuid", nil
 }
+
+// Watch subscribes to the changes topic of the target runtime, a gap in the sequence is filled by asking the runtime to replay the missed events
+func (m *MQTTClient) Watch(opts *Opts, req *runtime.WatchRequest, callback func(*runtime.ChangeEvent, error)) (func(), error) {
+	if opts == nil {
+		return nil, fmt.Errorf("opts body can not be empty")
+	}
+	if req == nil {
+		req = &runtime.WatchRequest{}
+	}
+	topic := rxlib.ChangesTopic(opts.TargetGlobalID)
+	events := make(chan *runtime.ChangeEvent, 256)
+	done := make(chan struct{})
+	err := m.mqttClient.Subscribe(topic, func(topic string, payload []byte) {
+		event := &runtime.ChangeEvent{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payload, event); err != nil {
+			callback(nil, fmt.Errorf("watch changes: %v", err))
+			return
+		}
+		select {
+		case events <- event:
+		case <-done:
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		last := req.GetAfterSequence()
+		if last > 0 {
+			last = m.replayChanges(opts, req, last, callback)
+		}
+		for {
+			select {
+			case <-done:
+				return
+			case event := <-events:
+				if last > 0 && event.GetSequence() > last+1 {
+					last = m.replayChanges(opts, req, last, callback)
+				}
+				if event.GetSequence() <= last {
+					continue
+				}
+				last = event.GetSequence()
+				if rxlib.MatchChange(req, event) {
+					callback(event, nil)
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			m.mqttClient.Unsubscribe(topic)
+			close(done)
+		})
+	}
+	return stop, nil
+}
+
+// replayChanges asks the runtime for the events after the sequence on the ChangesReplayTopic, it returns the sequence of the last event replayed
+func (m *MQTTClient) replayChanges(opts *Opts, req *runtime.WatchRequest, after uint64, callback func(*runtime.ChangeEvent, error)) uint64 {
+	replay := &runtime.WatchRequest{AfterSequence: after, Types: req.GetTypes(), ObjectUUID: req.GetObjectUUID()}
+	body, err := protojson.Marshal(replay)
+	if err != nil {
+		callback(nil, fmt.Errorf("watch changes replay: %v", err))
+		return after
+	}
+	requestTopic := fmt.Sprintf("%s/%s", rxlib.ChangesReplayTopic(opts.TargetGlobalID), helpers.UUID())
+	responseTopic := fmt.Sprintf("%s/response", requestTopic)
+	responses := make(chan []byte, 1)
+	err = m.mqttClient.Subscribe(responseTopic, func(topic string, payload []byte) {
+		select {
+		case responses <- payload:
+		default:
+		}
+	})
+	if err != nil {
+		callback(nil, fmt.Errorf("watch changes replay: %v", err))
+		return after
+	}
+	defer m.mqttClient.Unsubscribe(responseTopic)
+	if err := m.mqttClient.Publish(requestTopic, body); err != nil {
+		callback(nil, fmt.Errorf("watch changes replay: %v", err))
+		return after
+	}
+
+	select {
+	case payload := <-responses:
+		events := &runtime.ChangeEvents{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payload, events); err != nil {
+			callback(nil, fmt.Errorf("watch changes replay: %v", err))
+			return after
+		}
+		for _, event := range events.GetEvents() {
+			if event.GetSequence() > after || event.GetType() == string(rxlib.ChangeReset) {
+				after = event.GetSequence()
+				callback(event, nil)
+			}
+		}
+	case <-time.After(2 * time.Second):
+		callback(nil, fmt.Errorf("watch changes replay: timeout occurred"))
+	}
+	return after
+}
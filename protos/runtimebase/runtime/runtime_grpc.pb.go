@@ -83,6 +83,7 @@ const (
 	RuntimeService_DeleteRole_FullMethodName                  = "/App.Runtime.RuntimeService/DeleteRole"
 	RuntimeService_UpdateRolePermissions_FullMethodName       = "/App.Runtime.RuntimeService/UpdateRolePermissions"
 	RuntimeService_PluginStream_FullMethodName                = "/App.Runtime.RuntimeService/PluginStream"
+	RuntimeService_WatchChanges_FullMethodName                = "/App.Runtime.RuntimeService/WatchChanges"
 )
 
 // RuntimeServiceClient is the client API for RuntimeService service.
@@ -113,7 +114,7 @@ type RuntimeServiceClient interface {
 	GetObjectValues(ctx context.Context, in *ObjectsValueRequest, opts ...grpc.CallOption) (*GetObjectValuesResponse, error)
 	// single port value for an object
 	GetPortValue(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*PortValue, error)
-	// Extensions
+	// Plugin
 	RegisterPlugin(ctx context.Context, in *Plugin, opts ...grpc.CallOption) (*Plugin, error)
 	AddPlugin(ctx context.Context, in *Plugin, opts ...grpc.CallOption) (*Plugin, error)
 	DeletePlugin(ctx context.Context, in *PluginId, opts ...grpc.CallOption) (*Empty, error)
@@ -164,6 +165,8 @@ type RuntimeServiceClient interface {
 	UpdateRolePermissions(ctx context.Context, in *PermissionsRequest, opts ...grpc.CallOption) (*PermissionsResponse, error)
 	// stream messages from the server to the plugin
 	PluginStream(ctx context.Context, opts ...grpc.CallOption) (RuntimeService_PluginStreamClient, error)
+	// stream the object, connection, port value and status changes of the runtime
+	WatchChanges(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (RuntimeService_WatchChangesClient, error)
 }
 
 type runtimeServiceClient struct {
@@ -772,6 +775,38 @@ func (x *runtimeServicePluginStreamClient) Recv() (*MessageRequest, error) {
 	return m, nil
 }
 
+func (c *runtimeServiceClient) WatchChanges(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (RuntimeService_WatchChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RuntimeService_ServiceDesc.Streams[1], RuntimeService_WatchChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeServiceWatchChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RuntimeService_WatchChangesClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type runtimeServiceWatchChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeServiceWatchChangesClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RuntimeServiceServer is the server API for RuntimeService service.
 // All implementations must embed UnimplementedRuntimeServiceServer
 // for forward compatibility
@@ -800,7 +835,7 @@ type RuntimeServiceServer interface {
 	GetObjectValues(context.Context, *ObjectsValueRequest) (*GetObjectValuesResponse, error)
 	// single port value for an object
 	GetPortValue(context.Context, *PortRequest) (*PortValue, error)
-	// Extensions
+	// Plugin
 	RegisterPlugin(context.Context, *Plugin) (*Plugin, error)
 	AddPlugin(context.Context, *Plugin) (*Plugin, error)
 	DeletePlugin(context.Context, *PluginId) (*Empty, error)
@@ -851,6 +886,8 @@ type RuntimeServiceServer interface {
 	UpdateRolePermissions(context.Context, *PermissionsRequest) (*PermissionsResponse, error)
 	// stream messages from the server to the plugin
 	PluginStream(RuntimeService_PluginStreamServer) error
+	// stream the object, connection, port value and status changes of the runtime
+	WatchChanges(*WatchRequest, RuntimeService_WatchChangesServer) error
 	mustEmbedUnimplementedRuntimeServiceServer()
 }
 
@@ -1050,6 +1087,9 @@ func (UnimplementedRuntimeServiceServer) UpdateRolePermissions(context.Context,
 func (UnimplementedRuntimeServiceServer) PluginStream(RuntimeService_PluginStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method PluginStream not implemented")
 }
+func (UnimplementedRuntimeServiceServer) WatchChanges(*WatchRequest, RuntimeService_WatchChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
 func (UnimplementedRuntimeServiceServer) mustEmbedUnimplementedRuntimeServiceServer() {}
 
 // UnsafeRuntimeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2223,6 +2263,27 @@ func (x *runtimeServicePluginStreamServer) Recv() (*MessageRequest, error) {
 	return m, nil
 }
 
+func _RuntimeService_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).WatchChanges(m, &runtimeServiceWatchChangesServer{stream})
+}
+
+type RuntimeService_WatchChangesServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type runtimeServiceWatchChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeServiceWatchChangesServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RuntimeService_ServiceDesc is the grpc.ServiceDesc for RuntimeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2490,6 +2551,11 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _RuntimeService_WatchChanges_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "runtime.proto",
 }
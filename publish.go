@@ -0,0 +1,234 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"log"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// PublishOpts throttles how often an output is published by SetOutput() and PublishValue(), the zero value publishes every value
+type PublishOpts struct {
+	// COVIncrement a float or int output is only published when it changed by at least the increment from the last published value
+	COVIncrement float64 `json:"covIncrement,omitempty"`
+	// COVPercent a float or int output is only published when it changed by at least the percent of the last published value
+	COVPercent float64 `json:"covPercent,omitempty"`
+	// MinInterval a change is held back until the interval has passed from the last publish, the latest value is then published
+	MinInterval time.Duration `json:"minInterval,omitempty"`
+	// MaxInterval a value that is not a change of value is still published when the last publish is older than the interval
+	MaxInterval time.Duration `json:"maxInterval,omitempty"`
+	// Heartbeat the current value is published again when it has not been published for the interval
+	Heartbeat time.Duration `json:"heartbeat,omitempty"`
+	// Debounce a bool output is only published when its new value stays the same for the interval
+	Debounce time.Duration `json:"debounce,omitempty"`
+}
+
+func (o *PublishOpts) hasCOV() bool {
+	return o != nil && (o.COVIncrement > 0 || o.COVPercent > 0)
+}
+
+// changed is if the value is a change of value from the last published value, using the COV increment of a number
+func (o *PublishOpts) changed(last, next *runtime.PortValue) bool {
+	if last == nil {
+		return true
+	}
+	if !o.hasCOV() || last.GetIsNil() != next.GetIsNil() || last.GetQuality() != next.GetQuality() {
+		return !sameValue(last, next)
+	}
+	a, aOk := portValueNumber(last)
+	b, bOk := portValueNumber(next)
+	if !aOk || !bOk {
+		return !sameValue(last, next)
+	}
+	diff := math.Abs(b - a)
+	if o.COVIncrement > 0 && diff < o.COVIncrement {
+		return false
+	}
+	if o.COVPercent > 0 && diff < math.Abs(a)*o.COVPercent/100 {
+		return false
+	}
+	return diff > 0
+}
+
+func portValueNumber(value *runtime.PortValue) (float64, bool) {
+	switch {
+	case value.FloatValue != nil:
+		return value.GetFloatValue(), true
+	case value.IntValue != nil:
+		return float64(value.GetIntValue()), true
+	}
+	return 0, false
+}
+
+// publishPolicy is a copy of the publish options of an output, taken with the object mutex held
+type publishPolicy struct {
+	opts   PublishOpts
+	set    bool // the output has PublishOpts
+	cov    bool // OnlyPublishOnCOV
+	isBool bool
+}
+
+// newPublishPolicy must be called with the object mutex held
+func newPublishPolicy(port *Port) *publishPolicy {
+	policy := &publishPolicy{cov: port.OnlyPublishCOV(), isBool: port.GetDataType() == priority.TypeBool}
+	if port.Publish != nil {
+		policy.opts, policy.set = *port.Publish, true
+	}
+	return policy
+}
+
+// publisher is the publish state of an output
+type publisher struct {
+	mutex       sync.Mutex
+	published   *runtime.PortValue
+	publishedAt time.Time
+	pending     *time.Timer // publishes the latest value after the MinInterval
+	debounce    *time.Timer
+	debounced   *runtime.PortValue // the value that has to stay the same for the Debounce
+	heartbeat   *time.Timer
+	stopped     bool // a timer that fires after the stop does not publish
+}
+
+func (p *publisher) stop() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, timer := range []*time.Timer{p.pending, p.debounce, p.heartbeat} {
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+	p.pending, p.debounce, p.heartbeat, p.debounced = nil, nil, nil, nil
+	p.stopped = true
+}
+
+func (p *publisher) isStopped() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.stopped
+}
+
+func (inst *BaseObject) publisher(portID string) *publisher {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	p, ok := inst.publishers[portID]
+	if !ok {
+		p = &publisher{}
+		inst.publishers[portID] = p
+	}
+	return p
+}
+
+// stopPublishers stops the timers of the outputs, or of all the outputs when none are given
+func (inst *BaseObject) stopPublishers(portIDs ...string) {
+	inst.mutex.Lock()
+	var stop []*publisher
+	for portID, p := range inst.publishers {
+		if len(portIDs) == 0 || slices.Contains(portIDs, portID) {
+			stop = append(stop, p)
+			delete(inst.publishers, portID)
+		}
+	}
+	inst.mutex.Unlock()
+	for _, p := range stop {
+		p.stop()
+	}
+}
+
+// shouldPublish is if SetOutput() or PublishValue() publishes the value now, a value held back by the MinInterval or Debounce is published by a timer
+func (inst *BaseObject) shouldPublish(portID string, policy *publishPolicy, value *runtime.PortValue) bool {
+	if !policy.set && !policy.cov {
+		return true
+	}
+	opts := &policy.opts
+	p := inst.publisher(portID)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	now := time.Now()
+
+	if opts.Debounce > 0 && policy.isBool && p.published != nil {
+		if sameValue(p.published, value) {
+			if p.debounce != nil {
+				p.debounce.Stop()
+				p.debounce, p.debounced = nil, nil
+			}
+			return false
+		}
+		if p.debounced == nil || !sameValue(p.debounced, value) {
+			if p.debounce != nil {
+				p.debounce.Stop()
+			}
+			p.debounced = value
+			p.debounce = time.AfterFunc(opts.Debounce, func() { inst.publishDebounced(portID, p, value) })
+		}
+		return false
+	}
+
+	if (policy.cov || opts.hasCOV()) && !opts.changed(p.published, value) {
+		return opts.MaxInterval > 0 && now.Sub(p.publishedAt) >= opts.MaxInterval
+	}
+	if opts.MinInterval > 0 && !p.publishedAt.IsZero() {
+		if wait := opts.MinInterval - now.Sub(p.publishedAt); wait > 0 {
+			if p.pending == nil {
+				p.pending = time.AfterFunc(wait, func() { inst.publishPending(portID, p) })
+			}
+			return false
+		}
+	}
+	return true
+}
+
+func (inst *BaseObject) publishPending(portID string, p *publisher) {
+	p.mutex.Lock()
+	p.pending = nil
+	p.mutex.Unlock()
+	inst.publishTimer(portID, p, "pending")
+}
+
+// publishDebounced publishes the bool when it is still the value the debounce was started with
+func (inst *BaseObject) publishDebounced(portID string, p *publisher, value *runtime.PortValue) {
+	p.mutex.Lock()
+	stable := p.debounced == value
+	p.debounce, p.debounced = nil, nil
+	p.mutex.Unlock()
+	if !stable || !sameValue(inst.GetPortValue(portID), value) {
+		return
+	}
+	inst.publishTimer(portID, p, "debounce")
+}
+
+// publishTimer publishes the output from a timer of the publisher, the publisher is not made again once it is stopped
+func (inst *BaseObject) publishTimer(portID string, p *publisher, timer string) {
+	if p.isStopped() {
+		return
+	}
+	if err := inst.publishValue(portID, p); err != nil {
+		log.Printf("object: %s output: %s %s err: %v", inst.GetUUID(), portID, timer, err)
+	}
+}
+
+// published records the published value for the COV, and starts the heartbeat again; a timer passes its own publisher
+func (inst *BaseObject) published(portID string, policy *publishPolicy, p *publisher, value *runtime.PortValue) {
+	if p == nil {
+		p = inst.publisher(portID)
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.stopped {
+		return
+	}
+	p.published, p.publishedAt = value, time.Now()
+	if p.pending != nil {
+		p.pending.Stop()
+		p.pending = nil
+	}
+	if policy.opts.Heartbeat <= 0 {
+		return
+	}
+	if p.heartbeat != nil {
+		p.heartbeat.Stop()
+	}
+	p.heartbeat = time.AfterFunc(policy.opts.Heartbeat, func() { inst.publishTimer(portID, p, "heartbeat") })
+}
@@ -0,0 +1,136 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/payload"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newPublishTest connects the output to a float input of another object, and returns the values it receives
+func newPublishTest(t *testing.T, port *NewPort) (*baseAdd, func() []float64) {
+	b := newTestBus()
+	a := newBaseAdd("a", b)
+	if err := a.NewOutputPort(port); err != nil {
+		t.Fatal(err)
+	}
+	var mutex sync.Mutex
+	var values []float64
+	c := NewBaseObject(&runtime.Info{ObjectID: "c"}, &BaseObjectOpts{Bus: b})
+	_ = c.SetMeta(&runtime.Meta{ObjectUUID: "c"})
+	_ = c.NewInputPort(NewPortFloatCallBack("in", func(portID string, p *payload.Payload) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		values = append(values, p.GetFloatValue())
+	}))
+	c.AddSubscriptionConnection("a", port.ID, "c", "in")
+	return a, func() []float64 {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return slices.Clone(values)
+	}
+}
+
+func waitFor(t *testing.T, f func() bool) {
+	t.Helper()
+	for start := time.Now(); !f(); time.Sleep(time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatal("timeout")
+		}
+	}
+}
+
+func TestPublishCOVIncrement(t *testing.T) {
+	a, updated := newPublishTest(t, NewPortFloat("cov", &PortOpts{Publish: &PublishOpts{COVIncrement: 0.5}}))
+	for _, v := range []float64{1, 1.001, 1.3, 1.5, 1.6, 0.9} {
+		_ = a.SetOutput("cov", v)
+	}
+	if values := updated(); !slices.Equal(values, []float64{1, 1.5, 0.9}) {
+		t.Fatalf("unexpected values: %v", values)
+	}
+
+	a, updated = newPublishTest(t, NewPortFloat("cov", &PortOpts{Publish: &PublishOpts{COVPercent: 10, MaxInterval: 20 * time.Millisecond}}))
+	for _, v := range []float64{100, 105, 111} {
+		_ = a.SetOutput("cov", v)
+	}
+	time.Sleep(25 * time.Millisecond)
+	_ = a.SetOutput("cov", 112)
+	if values := updated(); !slices.Equal(values, []float64{100, 111, 112}) {
+		t.Fatalf("unexpected values: %v", values)
+	}
+}
+
+func TestPublishIntervals(t *testing.T) {
+	a, updated := newPublishTest(t, NewPortFloat("out-1", &PortOpts{Publish: &PublishOpts{MinInterval: 30 * time.Millisecond}}))
+	for _, v := range []float64{1, 2, 3, 4} {
+		_ = a.SetOutput("out-1", v)
+	}
+	if values := updated(); !slices.Equal(values, []float64{1}) {
+		t.Fatalf("expected the changes to be held back: %v", values)
+	}
+	waitFor(t, func() bool { return slices.Equal(updated(), []float64{1, 4}) })
+
+	a, updated = newPublishTest(t, NewPortFloat("out-1", &PortOpts{OnlyPublishOnCOV: true, Publish: &PublishOpts{Heartbeat: 10 * time.Millisecond}}))
+	_ = a.SetOutput("out-1", 7)
+	_ = a.SetOutput("out-1", 7)
+	waitFor(t, func() bool { return len(updated()) >= 3 })
+	if err := a.DeleteOutput("out-1"); err != nil {
+		t.Fatal(err)
+	}
+	count := len(updated())
+	time.Sleep(30 * time.Millisecond)
+	if len(updated()) > count+1 {
+		t.Fatal("expected the heartbeat to stop with the output")
+	}
+	// a heartbeat that fired as the publishers were stopped does not make the publisher again
+	a, updated = newPublishTest(t, NewPortFloat("out-2", &PortOpts{Publish: &PublishOpts{Heartbeat: time.Hour}}))
+	_ = a.SetOutput("out-2", 1)
+	p := a.publisher("out-2")
+	a.stopPublishers()
+	a.publishTimer("out-2", p, "heartbeat")
+	a.mutex.RLock()
+	publishers := len(a.publishers)
+	a.mutex.RUnlock()
+	if publishers != 0 || len(updated()) != 1 {
+		t.Fatalf("expected the stopped publisher to be left: %d %v", publishers, updated())
+	}
+}
+
+func TestPublishDebounce(t *testing.T) {
+	a, updated := newPublishTest(t, NewPortBool("alarm", &PortOpts{Publish: &PublishOpts{Debounce: 20 * time.Millisecond}}))
+	_ = a.SetOutput("alarm", false)
+	_ = a.SetOutput("alarm", true)
+	_ = a.SetOutput("alarm", false)
+	time.Sleep(30 * time.Millisecond)
+	if values := updated(); !slices.Equal(values, []float64{0}) {
+		t.Fatalf("expected the bounce to not be published: %v", values)
+	}
+	_ = a.SetOutput("alarm", true)
+	_ = a.SetOutput("alarm", true)
+	waitFor(t, func() bool { return slices.Equal(updated(), []float64{0, 1}) })
+}
+
+func TestPublishValueThrottled(t *testing.T) {
+	a, updated := newPublishTest(t, NewPortFloat("out-1", &PortOpts{OnlyPublishOnCOV: true, Publish: &PublishOpts{MinInterval: 30 * time.Millisecond}}))
+	_ = a.SetOutput("out-1", 1)
+	for i := 0; i < 10; i++ {
+		if err := a.PublishValue("out-1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if values := updated(); !slices.Equal(values, []float64{1}) {
+		t.Fatalf("expected the unchanged value to not be published again: %v", values)
+	}
+	// a change is held back by the MinInterval, the same as SetOutput()
+	a.mutex.Lock()
+	a.findPort("out-1").Payload.PortValue.FloatValue = ptr(2.0)
+	a.mutex.Unlock()
+	_ = a.PublishValue("out-1")
+	_ = a.PublishValue("out-1")
+	if values := updated(); !slices.Equal(values, []float64{1}) {
+		t.Fatalf("expected the change to be held back: %v", values)
+	}
+	waitFor(t, func() bool { return slices.Equal(updated(), []float64{1, 2}) })
+}
@@ -50,6 +50,8 @@ func (inst *RuntimeImpl) CommandObject(command *ExtendedCommand) *CommandRespons
 		return inst.handleValues(parsedArgs)
 	case "objects", "object", "command":
 		return inst.handleObjects(parsedArgs)
+	case commandOverrides:
+		return inst.handleOverrides(parsedArgs)
 	default:
 		inst.response.Error = fmt.Sprintf("unknown command type: %s", parsedArgs.Thing)
 		return inst.response
@@ -210,7 +212,7 @@ func (inst *RuntimeImpl) handleCommandTypeObjects(parsedArgs *ParsedCommand, obj
 		if parsedArgs.GetThing() == "command" {
 			parsedArgs.SetReturnAsIfNil("command")
 			for _, object := range objects {
-				inst.response.CommandResponse = append(inst.response.CommandResponse, object.CommandObject(inst.command))
+				inst.response.CommandResponse = append(inst.response.CommandResponse, inst.commandObject(object, inst.command))
 			}
 		}
 
@@ -346,6 +348,8 @@ const (
 	commandOutputs = "outputs"
 	commandInput   = "input"
 	commandInputs  = "inputs"
+
+	commandOverrides = "overrides"
 )
 
 func convertCommand(resp *CommandResponse) *runtime.CommandResponse {
@@ -0,0 +1,168 @@
+package rxlib
+
+// objectIndex indexes the runtime objects so the lookups don't need to scan all the objects, it is guarded by the runtime mutex.
+// The zero value is ready to use; each bucket keeps the objects in the order they were added
+type objectIndex struct {
+	byUUID         map[string]Object
+	byID           map[string][]Object
+	byName         map[string][]Object
+	byParent       map[string][]Object
+	byCategory     map[string][]Object
+	byWorkingGroup map[string][]Object
+	byTag          map[string][]Object
+	keys           map[string]*indexKeys // by uuid
+}
+
+// indexKeys are the keys an object was indexed with, so it can be removed after it has been renamed or moved
+type indexKeys struct {
+	id           string
+	name         string
+	parent       string
+	category     string
+	workingGroup string
+	tags         []string
+}
+
+func (idx *objectIndex) reset() {
+	*idx = objectIndex{}
+}
+
+func (idx *objectIndex) add(object Object) {
+	if idx.byUUID == nil {
+		idx.byUUID = make(map[string]Object)
+		idx.byID = make(map[string][]Object)
+		idx.byName = make(map[string][]Object)
+		idx.byParent = make(map[string][]Object)
+		idx.byCategory = make(map[string][]Object)
+		idx.byWorkingGroup = make(map[string][]Object)
+		idx.byTag = make(map[string][]Object)
+		idx.keys = make(map[string]*indexKeys)
+	}
+	keys := &indexKeys{
+		id:           object.GetID(),
+		name:         object.GetName(),
+		parent:       object.GetParentUUID(),
+		category:     object.GetCategory(),
+		workingGroup: object.GetWorkingGroup(),
+		tags:         object.GetTags(),
+	}
+	uuid := object.GetUUID()
+	idx.byUUID[uuid] = object
+	idx.keys[uuid] = keys
+	idx.byID[keys.id] = append(idx.byID[keys.id], object)
+	idx.byName[keys.name] = append(idx.byName[keys.name], object)
+	idx.byParent[keys.parent] = append(idx.byParent[keys.parent], object)
+	idx.byCategory[keys.category] = append(idx.byCategory[keys.category], object)
+	idx.byWorkingGroup[keys.workingGroup] = append(idx.byWorkingGroup[keys.workingGroup], object)
+	for _, tag := range keys.tags {
+		idx.byTag[tag] = append(idx.byTag[tag], object)
+	}
+}
+
+func (idx *objectIndex) remove(uuid string) {
+	object, ok := idx.byUUID[uuid]
+	if !ok {
+		return
+	}
+	keys := idx.keys[uuid]
+	delete(idx.byUUID, uuid)
+	delete(idx.keys, uuid)
+	removeFromBucket(idx.byID, keys.id, object)
+	removeFromBucket(idx.byName, keys.name, object)
+	removeFromBucket(idx.byParent, keys.parent, object)
+	removeFromBucket(idx.byCategory, keys.category, object)
+	removeFromBucket(idx.byWorkingGroup, keys.workingGroup, object)
+	for _, tag := range keys.tags {
+		removeFromBucket(idx.byTag, tag, object)
+	}
+}
+
+func removeFromBucket(buckets map[string][]Object, key string, object Object) {
+	bucket := buckets[key]
+	for i, o := range bucket {
+		if o == object {
+			bucket = append(bucket[:i], bucket[i+1:]...)
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		delete(buckets, key)
+		return
+	}
+	buckets[key] = bucket
+}
+
+// objects returns a copy of the bucket, so the caller can't change the index
+func (idx *objectIndex) objects(buckets map[string][]Object, key string) []Object {
+	bucket := buckets[key]
+	if len(bucket) == 0 {
+		return nil
+	}
+	return append([]Object(nil), bucket...)
+}
+
+func (idx *objectIndex) first(buckets map[string][]Object, key string) Object {
+	bucket := buckets[key]
+	if len(bucket) == 0 {
+		return nil
+	}
+	return bucket[0]
+}
+
+// setObjects replaces all the runtime objects and returns the objects added and removed, the caller must hold the mutex
+func (inst *RuntimeImpl) setObjects(objects []Object) (added, removed []Object) {
+	previous := inst.index.byUUID
+	inst.objects = nil
+	inst.index.reset()
+	for _, object := range objects {
+		inst.addObject(object)
+	}
+	for _, object := range inst.objects {
+		if _, ok := previous[object.GetUUID()]; !ok {
+			added = append(added, object)
+		}
+	}
+	for uuid, object := range previous {
+		if _, ok := inst.index.byUUID[uuid]; !ok {
+			removed = append(removed, object)
+		}
+	}
+	return added, removed
+}
+
+// addObject adds or replaces an object with the same uuid and attaches the runtime to it, it returns false if the object was replaced;
+// the caller must hold the mutex, so AddRuntime() must not call back into the runtime
+func (inst *RuntimeImpl) addObject(object Object) bool {
+	if object == nil {
+		return false
+	}
+	uuid := object.GetUUID()
+	if existing, ok := inst.index.byUUID[uuid]; ok {
+		inst.index.remove(uuid)
+		for i, o := range inst.objects {
+			if o == existing {
+				inst.objects[i] = object
+				break
+			}
+		}
+		inst.index.add(object)
+		object.AddRuntime(inst)
+		return false
+	}
+	inst.objects = append(inst.objects, object)
+	inst.index.add(object)
+	object.AddRuntime(inst)
+	return true
+}
+
+// ReindexObject updates the lookups of an object after its name, parent, category, working group or tags have changed
+func (inst *RuntimeImpl) ReindexObject(uuid string) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	object, ok := inst.index.byUUID[uuid]
+	if !ok {
+		return
+	}
+	inst.index.remove(uuid)
+	inst.index.add(object)
+}
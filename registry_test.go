@@ -0,0 +1,132 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"slices"
+	"testing"
+)
+
+// testObject implements what the runtime object index needs, the other tests embed it
+type testObject struct {
+	Object
+	uuid         string
+	id           string
+	name         string
+	parent       string
+	category     string
+	workingGroup string
+	tags         []string
+	info         *runtime.Info
+}
+
+func (o *testObject) GetUUID() string                       { return o.uuid }
+func (o *testObject) GetID() string                         { return o.id }
+func (o *testObject) GetName() string                       { return o.name }
+func (o *testObject) GetParentUUID() string                 { return o.parent }
+func (o *testObject) GetCategory() string                   { return o.category }
+func (o *testObject) GetWorkingGroup() string               { return o.workingGroup }
+func (o *testObject) GetTags() []string                     { return o.tags }
+func (o *testObject) GetInfo() *runtime.Info                { return o.info }
+func (o *testObject) AddRuntime(r Runtime)                  {}
+func (o *testObject) GetConnections() []*runtime.Connection { return nil }
+
+func TestObjectIndex(t *testing.T) {
+	network := &testObject{uuid: "net", id: "network", name: "bacnet", category: "driver", workingGroup: "bacnet"}
+	device1 := &testObject{uuid: "dev1", id: "device", name: "ahu", parent: "net", category: "driver", workingGroup: "bacnet", tags: []string{"ahu"}}
+	device2 := &testObject{uuid: "dev2", id: "device", name: "fcu", parent: "net", category: "driver", workingGroup: "bacnet"}
+	add := &testObject{uuid: "add", id: "add", name: "add", category: "logic", tags: []string{"ahu", "math"}}
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{network, device1, device2})
+	r.AddObject(add)
+
+	if r.GetByUUID("dev2") != device2 || r.GetByUUID("nope") != nil {
+		t.Fatal("unexpected GetByUUID")
+	}
+	if r.GetFirstByID("device") != device1 || len(r.GetAllByID("device")) != 2 {
+		t.Fatal("unexpected GetFirstByID/GetAllByID")
+	}
+	if r.GetFirstByName("fcu") != device2 || len(r.GetAllByName("bacnet")) != 1 {
+		t.Fatal("unexpected GetFirstByName/GetAllByName")
+	}
+	if children := r.GetChildObjects("net"); len(children) != 2 || children[0] != device1 {
+		t.Fatalf("unexpected GetChildObjects: %v", children)
+	}
+	if len(r.GetChildObjectsByWorkingGroup("net", "bacnet")) != 2 || len(r.GetAllByWorkingGroup("bacnet")) != 3 {
+		t.Fatal("unexpected working group lookups")
+	}
+	if len(r.GetAllByCategory("logic")) != 1 || len(r.GetAllByTag("ahu")) != 2 {
+		t.Fatal("unexpected category/tag lookups")
+	}
+
+	// a returned slice can't change the index
+	children := r.GetChildObjects("net")
+	children[0] = add
+	if r.GetChildObjects("net")[0] != device1 {
+		t.Fatal("expected the index to not be changed by the caller")
+	}
+
+	all := r.Get()
+	before := slices.Clone(all)
+	if err := r.DeleteByUUID("dev1"); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(all, before) {
+		t.Fatal("expected a slice from Get() to not be changed by a delete")
+	}
+	if r.GetByUUID("dev1") != nil || len(r.GetChildObjects("net")) != 1 || len(r.GetAllByTag("ahu")) != 1 || len(r.Get()) != 3 {
+		t.Fatal("expected dev1 to be removed from all the lookups")
+	}
+
+	// rename and reindex
+	device2.name = "vav"
+	r.ReindexObject("dev2")
+	if r.GetFirstByName("fcu") != nil || r.GetFirstByName("vav") != device2 {
+		t.Fatal("expected the object to be reindexed")
+	}
+
+	// an object with the same uuid replaces the existing one and keeps its position
+	replaced := &testObject{uuid: "net", id: "network", name: "modbus"}
+	r.AddObject(replaced)
+	if len(r.Get()) != 3 || r.Get()[0] != replaced || r.GetFirstByName("bacnet") != nil {
+		t.Fatal("expected the object to be replaced")
+	}
+
+	r.Delete()
+	if len(r.Get()) != 0 || r.GetByUUID("add") != nil {
+		t.Fatal("expected all objects to be deleted")
+	}
+}
+
+func TestBaseObjectReindex(t *testing.T) {
+	a := newBaseAdd("a", newTestBus())
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{a})
+	if a.Runtime() != r {
+		t.Fatal("expected the runtime to be attached to the added object")
+	}
+
+	a.SetName("pump")
+	a.AddTags("ahu")
+	if r.GetFirstByName("pump") != a || len(r.GetAllByTag("ahu")) != 1 {
+		t.Fatal("expected the setters to reindex the object")
+	}
+	if err := a.SetMeta(&runtime.Meta{ObjectUUID: "a", ObjectName: "fan", ParentUUID: "ahu-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if r.GetFirstByName("pump") != nil || r.GetFirstByName("fan") != a || len(r.GetChildObjects("ahu-1")) != 1 {
+		t.Fatal("expected SetMeta to reindex the name and parent")
+	}
+
+	// the info and meta are copied in and out, so they can't be changed without the object being reindexed
+	info := &runtime.Info{ObjectID: "add", Tags: []string{"vav"}}
+	a.SetInfo(info)
+	a.AddTags("fcu")
+	a.GetInfo().Tags[0] = "boiler"
+	a.GetMeta().ObjectName = "boiler"
+	if len(info.Tags) != 1 || info.Requirements != nil {
+		t.Fatal("expected the caller's info to not be changed")
+	}
+	if !slices.Equal(a.GetTags(), []string{"vav", "fcu"}) || a.GetName() != "fan" || len(r.GetAllByTag("boiler")) != 0 {
+		t.Fatalf("expected the object to not be changed: %v %s", a.GetTags(), a.GetName())
+	}
+}
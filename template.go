@@ -0,0 +1,538 @@
+package rxlib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/helpers"
+	"github.com/NubeIO/rxlib/libs/storage"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const TemplatesTable = "ros_templates"
+
+// TemplateContainerID is the object id of the container an instance of a template lives under, a factory must be registered for it
+const TemplateContainerID = "subflow"
+
+// TemplatePort is a port of an object in the template that is exposed on the container
+type TemplatePort struct {
+	ID         string `json:"id"` // the port id on the container
+	Name       string `json:"name,omitempty"`
+	ObjectUUID string `json:"objectUUID"` // the object in the template, or the object in the instance once it is instantiated
+	PortID     string `json:"portID"`
+}
+
+// Template is a reusable group of objects and the connections between them. The objects with no parent in the template are added under the container
+type Template struct {
+	Name    string                  `json:"name"`
+	Version int                     `json:"version"` // the next version each time the template is saved
+	Objects []*runtime.ObjectConfig `json:"-"`
+	Inputs  []*TemplatePort         `json:"inputs,omitempty"`
+	Outputs []*TemplatePort         `json:"outputs,omitempty"`
+}
+
+// TemplateInstance is kept as the settings of the container, so an instance is still known after an export or a restart
+type TemplateInstance struct {
+	Template     string            `json:"template"`
+	Version      int               `json:"version"`
+	Objects      map[string]string `json:"objects"`               // template object uuid -> instance object uuid
+	Connections  map[string]string `json:"connections,omitempty"` // template connection uuid -> instance connection uuid
+	Inputs       []*TemplatePort   `json:"inputs,omitempty"`
+	Outputs      []*TemplatePort   `json:"outputs,omitempty"`
+	WorkingGroup string            `json:"workingGroup,omitempty"` // the working group of the container and the instance objects
+}
+
+type InstantiateOpts struct {
+	Name         string // the name of the container, if empty the template name
+	ParentUUID   string // the parent of the container
+	WorkingGroup string // the working group of the container and the instance objects, if empty the working group of the parent
+}
+
+// TemplateManager saves groups of objects as templates, and adds instances of them under a container object. The templates
+// are kept in the runtime storage if it has one, and are loaded from it the first time they are used
+type TemplateManager interface {
+	// Save makes a template of the objects, their children and the connections between them; an existing template gets the next version. eg; Save("vav", []string{uuid}, inputs, outputs)
+	Save(name string, objectUUIDs []string, inputs, outputs []*TemplatePort) (*Template, error)
+	// Add adds or replaces a template, eg one from ReadTemplate()
+	Add(template *Template) error
+	Get(name string) *Template
+	List() []*Template
+	// Delete removes the template, its instances are left as they are
+	Delete(name string) error
+	// Instantiate deploys a container with a copy of the template objects under it, all with new uuids; it returns the container uuid
+	Instantiate(name string, opts *InstantiateOpts) (string, *DeployResponse)
+	// Instance returns the template, version and object uuids of the instance the container holds
+	Instance(containerUUID string) (*TemplateInstance, error)
+	// Instances returns the containers of the instances of the template
+	Instances(name string) []Object
+	// Propagate deploys the template to its instances that are on an older version, one deploy per instance. The connections to objects outside the instance are kept
+	Propagate(name string) []*DeployResponse
+	// ResolvePort returns the object and port in the instance an exposed port of the container is, use it to connect to the instance
+	ResolvePort(containerUUID, portID string) (objectUUID, objectPortID string, err error)
+}
+
+type templateManager struct {
+	runtime   *RuntimeImpl
+	mutex     sync.RWMutex
+	load      sync.Once
+	templates map[string]*Template
+}
+
+func newTemplateManager(r *RuntimeImpl) *templateManager {
+	return &templateManager{runtime: r, templates: make(map[string]*Template)}
+}
+
+func (inst *RuntimeImpl) Templates() TemplateManager {
+	return inst.templates
+}
+
+// loaded reads the saved templates from the storage once
+func (m *templateManager) loaded() {
+	m.load.Do(func() {
+		db := m.runtime.Storage()
+		if db == nil {
+			return
+		}
+		rows, err := db.Rows(TemplatesTable)
+		if err != nil {
+			log.Printf("load templates err: %v", err)
+			return
+		}
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		for _, row := range rows {
+			t, err := ReadTemplate([]byte(row.Value))
+			if err != nil {
+				log.Printf("load template: %s err: %v", row.Key, err)
+				continue
+			}
+			m.templates[t.Name] = t
+		}
+	})
+}
+
+// store saves the template to the storage, the runtime can have no storage
+func (m *templateManager) store(t *Template) error {
+	db := m.runtime.Storage()
+	if db == nil {
+		return nil
+	}
+	body, err := t.Marshal()
+	if err != nil {
+		return fmt.Errorf("save template: %s err: %v", t.Name, err)
+	}
+	if err := db.SaveRows(TemplatesTable, []*storage.Row{{Key: t.Name, Value: string(body), Time: time.Now()}}); err != nil {
+		return fmt.Errorf("save template: %s err: %v", t.Name, err)
+	}
+	return nil
+}
+
+func (m *templateManager) Save(name string, objectUUIDs []string, inputs, outputs []*TemplatePort) (*Template, error) {
+	m.loaded()
+	var objects []Object
+	seen := make(map[string]bool)
+	for _, uuid := range objectUUIDs {
+		object := m.runtime.GetByUUID(uuid)
+		if object == nil {
+			return nil, fmt.Errorf("not found object with uuid: %s", uuid)
+		}
+		objects = append(objects, object)
+	}
+	for i := 0; i < len(objects); i++ {
+		if seen[objects[i].GetUUID()] {
+			objects = append(objects[:i], objects[i+1:]...)
+			i--
+			continue
+		}
+		seen[objects[i].GetUUID()] = true
+		objects = append(objects, m.runtime.GetChildObjects(objects[i].GetUUID())...)
+	}
+	t := &Template{Name: name, Inputs: inputs, Outputs: outputs}
+	for _, config := range m.runtime.SerializeObjects(false, objects) {
+		config = exportConfig(config)
+		if !seen[config.GetMeta().GetParentUUID()] {
+			config.Meta.ParentUUID = ""
+		}
+		var kept []*runtime.Connection
+		for _, connection := range config.GetConnections() {
+			if seen[connection.GetSourceUUID()] && seen[connection.GetTargetUUID()] {
+				kept = append(kept, connection)
+			}
+		}
+		config.Connections = kept
+		t.Objects = append(t.Objects, config)
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if existing, ok := m.templates[name]; ok {
+		t.Version = existing.Version
+	}
+	t.Version++
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	if err := m.store(t); err != nil {
+		return nil, err
+	}
+	m.templates[name] = t
+	return t, nil
+}
+
+func (m *templateManager) Add(template *Template) error {
+	if template == nil {
+		return errors.New("template is nil")
+	}
+	if template.Version == 0 {
+		template.Version = 1
+	}
+	if err := template.validate(); err != nil {
+		return err
+	}
+	m.loaded()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if err := m.store(template); err != nil {
+		return err
+	}
+	m.templates[template.Name] = template
+	return nil
+}
+
+func (m *templateManager) Get(name string) *Template {
+	m.loaded()
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.templates[name]
+}
+
+func (m *templateManager) List() []*Template {
+	m.loaded()
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	out := make([]*Template, 0, len(m.templates))
+	for _, t := range m.templates {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func (m *templateManager) Delete(name string) error {
+	m.loaded()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if _, ok := m.templates[name]; !ok {
+		return fmt.Errorf("not found template: %s", name)
+	}
+	if db := m.runtime.Storage(); db != nil {
+		if err := db.DeleteRows(TemplatesTable, []string{name}); err != nil {
+			return fmt.Errorf("delete template: %s err: %v", name, err)
+		}
+	}
+	delete(m.templates, name)
+	return nil
+}
+
+// validate checks the objects have a uuid and id, and the exposed ports are on objects in the template
+func (t *Template) validate() error {
+	if t.Name == "" {
+		return errors.New("template name is empty")
+	}
+	if len(t.Objects) == 0 {
+		return fmt.Errorf("template: %s has no objects", t.Name)
+	}
+	uuids := make(map[string]bool, len(t.Objects))
+	for _, config := range t.Objects {
+		if err := validateObjectConfig(config); err != nil {
+			return fmt.Errorf("template: %s err: %v", t.Name, err)
+		}
+		uuids[config.GetMeta().GetObjectUUID()] = true
+	}
+	for _, ports := range [][]*TemplatePort{t.Inputs, t.Outputs} {
+		ids := make(map[string]bool, len(ports))
+		for _, port := range ports {
+			if port.ID == "" || port.PortID == "" {
+				return fmt.Errorf("template: %s exposed port id is empty", t.Name)
+			}
+			if ids[port.ID] {
+				return fmt.Errorf("template: %s exposed port: %s is used more than once", t.Name, port.ID)
+			}
+			ids[port.ID] = true
+			if !uuids[port.ObjectUUID] {
+				return fmt.Errorf("template: %s exposed port: %s not found object with uuid: %s", t.Name, port.ID, port.ObjectUUID)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *templateManager) Instantiate(name string, opts *InstantiateOpts) (string, *DeployResponse) {
+	t := m.Get(name)
+	if t == nil {
+		return "", &DeployResponse{Message: fmt.Sprintf("Instantiate failed. not found template: %s", name)}
+	}
+	if opts == nil {
+		opts = &InstantiateOpts{}
+	}
+	if opts.Name == "" {
+		opts.Name = t.Name
+	}
+	if opts.WorkingGroup == "" && opts.ParentUUID != "" {
+		if parent := m.runtime.GetByUUID(opts.ParentUUID); parent != nil {
+			opts.WorkingGroup = parent.GetWorkingGroup()
+		}
+	}
+	containerUUID := helpers.UUID()
+	instance := &TemplateInstance{Template: t.Name, WorkingGroup: opts.WorkingGroup}
+	configs := t.instanceConfigs(instance, containerUUID)
+	settings, err := json.Marshal(instance)
+	if err != nil {
+		return "", &DeployResponse{Message: fmt.Sprintf("Instantiate failed. err: %v", err)}
+	}
+	container := &runtime.ObjectConfig{
+		Id:       TemplateContainerID,
+		Meta:     &runtime.Meta{ObjectUUID: containerUUID, ObjectName: opts.Name, ParentUUID: opts.ParentUUID},
+		Settings: &runtime.ObjectSettings{Value: string(settings)},
+	}
+	resp := m.runtime.Deploy(&Deploy{New: append([]*runtime.ObjectConfig{container}, configs...)})
+	if !resp.Ok {
+		return "", resp
+	}
+	m.joinWorkingGroup(containerUUID, instance)
+	return containerUUID, resp
+}
+
+// joinWorkingGroup sets the working group of the instance on the container and the instance objects, the info is copied
+// as the objects of a factory can share it
+func (m *templateManager) joinWorkingGroup(containerUUID string, instance *TemplateInstance) {
+	if instance.WorkingGroup == "" {
+		return
+	}
+	uuids := []string{containerUUID}
+	for _, uuid := range instance.Objects {
+		uuids = append(uuids, uuid)
+	}
+	for _, uuid := range uuids {
+		object := m.runtime.GetByUUID(uuid)
+		if object == nil || object.GetWorkingGroup() == instance.WorkingGroup {
+			continue
+		}
+		info := &runtime.Info{}
+		if object.GetInfo() != nil {
+			info, _ = proto.Clone(object.GetInfo()).(*runtime.Info)
+		}
+		info.WorkingGroup = instance.WorkingGroup
+		object.SetInfo(info)
+	}
+}
+
+// instanceConfigs copies the template objects for the instance, the objects and connections not in the instance yet get new uuids
+func (t *Template) instanceConfigs(instance *TemplateInstance, containerUUID string) []*runtime.ObjectConfig {
+	objects := make(map[string]string, len(t.Objects))
+	for _, config := range t.Objects {
+		uuid := config.GetMeta().GetObjectUUID()
+		objects[uuid] = instance.Objects[uuid]
+		if objects[uuid] == "" {
+			objects[uuid] = helpers.UUID()
+		}
+	}
+	connections := make(map[string]string)
+	mapConnection := func(uuid string) string {
+		if uuid == "" {
+			return ""
+		}
+		if _, ok := connections[uuid]; !ok {
+			connections[uuid] = instance.Connections[uuid]
+			if connections[uuid] == "" {
+				connections[uuid] = helpers.UUID()
+			}
+		}
+		return connections[uuid]
+	}
+	var out []*runtime.ObjectConfig
+	for _, config := range t.Objects {
+		config, _ = proto.Clone(config).(*runtime.ObjectConfig)
+		meta := config.GetMeta()
+		meta.ObjectUUID = objects[meta.GetObjectUUID()]
+		if parent, ok := objects[meta.GetParentUUID()]; ok {
+			meta.ParentUUID = parent
+		} else {
+			meta.ParentUUID = containerUUID
+		}
+		for _, connection := range config.GetConnections() {
+			connection.SourceUUID = objects[connection.GetSourceUUID()]
+			connection.TargetUUID = objects[connection.GetTargetUUID()]
+			connection.ConnectionUUID = mapConnection(connection.GetConnectionUUID())
+			connection.TargetConnectionUUID = mapConnection(connection.GetTargetConnectionUUID())
+		}
+		out = append(out, config)
+	}
+	resolve := func(ports []*TemplatePort) []*TemplatePort {
+		var resolved []*TemplatePort
+		for _, port := range ports {
+			p := *port
+			p.ObjectUUID = objects[port.ObjectUUID]
+			resolved = append(resolved, &p)
+		}
+		return resolved
+	}
+	instance.Version = t.Version
+	instance.Objects = objects
+	instance.Connections = connections
+	instance.Inputs = resolve(t.Inputs)
+	instance.Outputs = resolve(t.Outputs)
+	return out
+}
+
+func (m *templateManager) Instance(containerUUID string) (*TemplateInstance, error) {
+	container := m.runtime.GetByUUID(containerUUID)
+	if container == nil {
+		return nil, fmt.Errorf("not found object with uuid: %s", containerUUID)
+	}
+	if container.GetID() != TemplateContainerID {
+		return nil, fmt.Errorf("object with uuid: %s is not a template container", containerUUID)
+	}
+	instance := &TemplateInstance{}
+	if err := json.Unmarshal([]byte(container.GetSettings().GetValue()), instance); err != nil {
+		return nil, fmt.Errorf("template container: %s settings err: %v", containerUUID, err)
+	}
+	return instance, nil
+}
+
+func (m *templateManager) Instances(name string) []Object {
+	var out []Object
+	for _, container := range m.runtime.GetAllByID(TemplateContainerID) {
+		if instance, err := m.Instance(container.GetUUID()); err == nil && instance.Template == name {
+			out = append(out, container)
+		}
+	}
+	return out
+}
+
+func (m *templateManager) Propagate(name string) []*DeployResponse {
+	t := m.Get(name)
+	if t == nil {
+		return []*DeployResponse{{Message: fmt.Sprintf("Propagate failed. not found template: %s", name)}}
+	}
+	var out []*DeployResponse
+	for _, container := range m.Instances(name) {
+		instance, err := m.Instance(container.GetUUID())
+		if err != nil {
+			out = append(out, &DeployResponse{Message: fmt.Sprintf("Propagate failed. err: %v", err)})
+			continue
+		}
+		if instance.Version == t.Version {
+			continue
+		}
+		out = append(out, m.propagate(t, container, instance))
+	}
+	return out
+}
+
+// propagate deploys the template to one instance, the objects removed from the template are deleted
+func (m *templateManager) propagate(t *Template, container Object, instance *TemplateInstance) *DeployResponse {
+	previous := instance.Objects
+	configs := t.instanceConfigs(instance, container.GetUUID())
+	inInstance := make(map[string]bool, len(previous)+len(instance.Objects))
+	for _, uuid := range previous {
+		inInstance[uuid] = true
+	}
+	for _, uuid := range instance.Objects {
+		inInstance[uuid] = true
+	}
+
+	body := &Deploy{}
+	for _, config := range configs {
+		object := m.runtime.GetByUUID(config.GetMeta().GetObjectUUID())
+		if object == nil {
+			body.New = append(body.New, config)
+			continue
+		}
+		// keep the connections to the objects outside the instance, eg one made to an exposed port
+		for _, connection := range object.GetConnections() {
+			if !inInstance[connection.GetSourceUUID()] || !inInstance[connection.GetTargetUUID()] {
+				config.Connections = append(config.Connections, connection)
+			}
+		}
+		body.Updated = append(body.Updated, config)
+	}
+	for templateUUID, uuid := range previous {
+		if _, ok := instance.Objects[templateUUID]; !ok && m.runtime.GetByUUID(uuid) != nil {
+			body.Deleted = append(body.Deleted, uuid)
+		}
+	}
+	sort.Strings(body.Deleted)
+
+	settings, err := json.Marshal(instance)
+	if err != nil {
+		return &DeployResponse{Message: fmt.Sprintf("Propagate failed. err: %v", err)}
+	}
+	config := exportConfig(m.runtime.serializeObject(false, container))
+	config.Settings = &runtime.ObjectSettings{Value: string(settings)}
+	body.Updated = append(body.Updated, config)
+	resp := m.runtime.Deploy(body)
+	if resp.Ok {
+		m.joinWorkingGroup(container.GetUUID(), instance)
+	}
+	return resp
+}
+
+func (m *templateManager) ResolvePort(containerUUID, portID string) (string, string, error) {
+	instance, err := m.Instance(containerUUID)
+	if err != nil {
+		return "", "", err
+	}
+	for _, ports := range [][]*TemplatePort{instance.Inputs, instance.Outputs} {
+		for _, port := range ports {
+			if port.ID == portID {
+				return port.ObjectUUID, port.PortID, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("template container: %s has no exposed port: %s", containerUUID, portID)
+}
+
+// Marshal encodes the template as JSON, the object configs use the protobuf JSON names
+func (t *Template) Marshal() ([]byte, error) {
+	objects := make([]map[string]any, 0, len(t.Objects))
+	for _, config := range t.Objects {
+		m, err := configToMap(config)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, m)
+	}
+	type alias Template
+	return json.Marshal(struct {
+		*alias
+		Objects []map[string]any `json:"objects"`
+	}{alias: (*alias)(t), Objects: objects})
+}
+
+// ReadTemplate decodes a template encoded with Marshal
+func ReadTemplate(data []byte) (*Template, error) {
+	type alias Template
+	raw := struct {
+		*alias
+		Objects []json.RawMessage `json:"objects"`
+	}{alias: &alias{}}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("read template err: %v", err)
+	}
+	t := (*Template)(raw.alias)
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	for i, o := range raw.Objects {
+		config := &runtime.ObjectConfig{}
+		if err := unmarshal.Unmarshal(o, config); err != nil {
+			return nil, fmt.Errorf("read template object: %d err: %v", i, err)
+		}
+		t.Objects = append(t.Objects, config)
+	}
+	return t, nil
+}
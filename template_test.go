@@ -0,0 +1,199 @@
+package rxlib
+
+import (
+	"github.com/NubeIO/rxlib/libs/storage"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func newTemplateRuntime(t *testing.T) *RuntimeImpl {
+	r, _ := newDeployRuntime()
+	r.templates = newTemplateManager(r)
+	factory := func(config *runtime.ObjectConfig) (Object, error) {
+		return &deployObject{testObject: testObject{id: config.GetId()}}, nil
+	}
+	r.RegisterObject(TemplateContainerID, factory)
+	r.RegisterObject("network", factory)
+	// network -> device is inside the template, counter -> device is not
+	publisher, subscriber := NewConnection("network", "out", "device", "in")
+	external, _ := NewConnection("counter", "out", "device", "in")
+	network := deployConfig("network", "network", "", "a")
+	network.Connections = []*runtime.Connection{publisher}
+	device := deployConfig("device", "device", "network", "a")
+	device.Connections = []*runtime.Connection{subscriber, external}
+	if resp := r.Deploy(&Deploy{Updated: []*runtime.ObjectConfig{network, device}}); !resp.Ok {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	return r
+}
+
+func TestTemplateInstantiate(t *testing.T) {
+	r := newTemplateRuntime(t)
+	templates := r.Templates()
+	inputs := []*TemplatePort{{ID: "in", ObjectUUID: "device", PortID: "in"}}
+	if _, err := templates.Save("vav", []string{"network"}, []*TemplatePort{{ID: "in", ObjectUUID: "counter", PortID: "in"}}, nil); err == nil {
+		t.Fatal("expected an exposed port of an object outside the template to fail")
+	}
+	template, err := templates.Save("vav", []string{"network"}, inputs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.Version != 1 || len(template.Objects) != 2 || template.Objects[0].GetMeta().GetParentUUID() != "" {
+		t.Fatalf("unexpected template: %+v", template)
+	}
+	if len(template.Objects[1].GetConnections()) != 1 {
+		t.Fatalf("expected only the connection inside the template, got: %v", template.Objects[1].GetConnections())
+	}
+
+	var containers []string
+	for _, name := range []string{"vav-1", "vav-2"} {
+		uuid, resp := templates.Instantiate("vav", &InstantiateOpts{Name: name})
+		if !resp.Ok {
+			t.Fatalf("unexpected response: %+v", resp)
+		}
+		containers = append(containers, uuid)
+	}
+	if len(templates.Instances("vav")) != 2 || containers[0] == containers[1] {
+		t.Fatal("expected two instances")
+	}
+	for _, container := range containers {
+		networks := r.GetChildObjects(container)
+		if len(networks) != 1 || networks[0].GetUUID() == "network" {
+			t.Fatalf("expected a copy of the network under the container, got: %v", networks)
+		}
+		devices := r.GetChildObjects(networks[0].GetUUID())
+		if len(devices) != 1 || devices[0].GetUUID() == "device" {
+			t.Fatalf("expected a copy of the device under the network, got: %v", devices)
+		}
+		connection := devices[0].GetConnections()[0]
+		if connection.GetSourceUUID() != networks[0].GetUUID() || connection.GetConnectionUUID() == template.Objects[1].GetConnections()[0].GetConnectionUUID() {
+			t.Fatalf("expected the connection to be between the copies, got: %v", connection)
+		}
+		objectUUID, portID, err := templates.ResolvePort(container, "in")
+		if err != nil || objectUUID != devices[0].GetUUID() || portID != "in" {
+			t.Fatalf("unexpected exposed port: %s %s %v", objectUUID, portID, err)
+		}
+	}
+	if _, _, err := templates.ResolvePort(containers[0], "nope"); err == nil {
+		t.Fatal("expected an unknown exposed port to fail")
+	}
+	// an instance under a parent joins its working group
+	r.GetByUUID("counter").(*deployObject).workingGroup = "vav"
+	container, resp := templates.Instantiate("vav", &InstantiateOpts{ParentUUID: "counter"})
+	if !resp.Ok {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	instance, _ := templates.Instance(container)
+	if r.GetByUUID(container).GetWorkingGroup() != "vav" || r.GetByUUID(instance.Objects["device"]).GetWorkingGroup() != "vav" || instance.WorkingGroup != "vav" {
+		t.Fatal("expected the container and the instance objects in the working group")
+	}
+	if r.GetByUUID(containers[0]).GetWorkingGroup() != "" {
+		t.Fatal("expected the instance with no working group to be left")
+	}
+}
+
+func TestTemplatePropagate(t *testing.T) {
+	r := newTemplateRuntime(t)
+	templates := r.Templates()
+	template, err := templates.Save("vav", []string{"network"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	container, resp := templates.Instantiate("vav", nil)
+	if !resp.Ok {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	instance, _ := templates.Instance(container)
+	device := r.GetByUUID(instance.Objects["device"]).(*deployObject)
+	external, _ := NewConnection("counter", "out", device.GetUUID(), "in2")
+	device.connections = append(device.connections, external)
+
+	// the device settings changed and an object was added
+	edited := &Template{Name: "vav", Version: template.Version + 1, Objects: []*runtime.ObjectConfig{
+		template.Objects[0],
+		proto.Clone(template.Objects[1]).(*runtime.ObjectConfig),
+		deployConfig("add", "add-1", "", "{}"),
+	}}
+	edited.Objects[1].Settings.Value = "b"
+	if err := templates.Add(edited); err != nil {
+		t.Fatal(err)
+	}
+	responses := templates.Propagate("vav")
+	if len(responses) != 1 || !responses[0].Ok {
+		t.Fatalf("unexpected responses: %+v", responses)
+	}
+	if device.settings != "b" || len(device.connections) != 2 || device.connections[1].GetConnectionUUID() != external.GetConnectionUUID() {
+		t.Fatalf("unexpected device after propagate: %s %v", device.settings, device.connections)
+	}
+	instance, _ = templates.Instance(container)
+	if instance.Version != 2 || r.GetByUUID(instance.Objects["add-1"]).GetParentUUID() != container {
+		t.Fatalf("unexpected instance: %+v", instance)
+	}
+	if responses := templates.Propagate("vav"); len(responses) != 0 {
+		t.Fatal("expected the instances on the template version to be left")
+	}
+
+	// removed from the template
+	edited = &Template{Name: "vav", Version: 3, Objects: edited.Objects[:2]}
+	_ = templates.Add(edited)
+	if responses := templates.Propagate("vav"); len(responses) != 1 || !responses[0].Ok || r.GetByUUID(instance.Objects["add-1"]) != nil {
+		t.Fatalf("expected the object removed from the template to be deleted: %+v", responses)
+	}
+}
+
+func TestTemplateMarshal(t *testing.T) {
+	r := newTemplateRuntime(t)
+	template, err := r.Templates().Save("vav", []string{"network"}, []*TemplatePort{{ID: "in", ObjectUUID: "device", PortID: "in"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := template.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadTemplate(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Name != "vav" || read.Version != 1 || len(read.Inputs) != 1 || len(read.Objects) != 2 {
+		t.Fatalf("unexpected template: %+v", read)
+	}
+	for i := range read.Objects {
+		if !proto.Equal(read.Objects[i], template.Objects[i]) {
+			t.Fatalf("unexpected object: %v", read.Objects[i])
+		}
+	}
+}
+
+func TestTemplateStorage(t *testing.T) {
+	db, err := storage.NewMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	r := newTemplateRuntime(t)
+	r.storage = db
+	if _, err := r.Templates().Save("vav", []string{"network"}, []*TemplatePort{{ID: "in", ObjectUUID: "device", PortID: "in"}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Templates().Save("ahu", []string{"counter"}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Templates().Delete("ahu"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a restarted runtime has the saved templates
+	r.templates = newTemplateManager(r)
+	templates := r.Templates().List()
+	if len(templates) != 1 || templates[0].Name != "vav" || templates[0].Version != 1 || len(templates[0].Objects) != 2 || len(templates[0].Inputs) != 1 {
+		t.Fatalf("unexpected templates: %+v", templates)
+	}
+	if _, err := r.Templates().Save("vav", []string{"network"}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if template := r.Templates().Get("vav"); template.Version != 2 {
+		t.Fatalf("expected the next version of the saved template, got: %d", template.Version)
+	}
+}
@@ -0,0 +1,597 @@
+package rxlib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/NubeIO/rxlib/priority"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"strings"
+	"time"
+)
+
+// ObjectFactory builds a new object for a deploy, the runtime then sets the meta, settings and connections from the config
+type ObjectFactory func(config *runtime.ObjectConfig) (Object, error)
+
+type DeployAction string
+
+const (
+	DeployNew     DeployAction = "new"
+	DeployUpdated DeployAction = "updated"
+	DeployDeleted DeployAction = "deleted"
+)
+
+// deployStopTimeout is how long the deleted objects, or the new objects on a rollback, have to stop
+const deployStopTimeout = 10 * time.Second
+
+type DeployResult struct {
+	ObjectUUID string       `json:"objectUUID"`
+	ObjectID   string       `json:"objectID,omitempty"`
+	Action     DeployAction `json:"action"`
+	Ok         bool         `json:"ok"`
+	Reset      bool         `json:"reset,omitempty"`      // Reset() was called as the object has CallResetOnDeploy set
+	RolledBack bool         `json:"rolledBack,omitempty"` // the change was not kept as another object failed
+	Error      string       `json:"error,omitempty"`
+}
+
+// RegisterObject adds the factory used to build the objects with the objectID on a deploy
+func (inst *RuntimeImpl) RegisterObject(objectID string, factory ObjectFactory) {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+	if inst.factories == nil {
+		inst.factories = make(map[string]ObjectFactory)
+	}
+	inst.factories[objectID] = factory
+}
+
+func (inst *RuntimeImpl) objectFactory(objectID string) ObjectFactory {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+	return inst.factories[objectID]
+}
+
+// deployTx applies a Deploy to the runtime, if any object fails the runtime is put back to the previous objects
+type deployTx struct {
+	runtime  *RuntimeImpl
+	body     *Deploy
+	results  []*DeployResult
+	byUUID   map[string]*DeployResult
+	previous []Object
+	existing map[string]Object
+	created  []Object
+	updated  []*deployUpdate
+	deleted  []Object
+	flow     bool // the flow graph was built before the deploy, so it needs to be built again
+}
+
+type deployUpdate struct {
+	object   Object
+	previous *runtime.ObjectConfig
+}
+
+func (inst *RuntimeImpl) Deploy(body *Deploy) *DeployResponse {
+	if body == nil {
+		return &DeployResponse{Message: "Deploy failed. body is nil"}
+	}
+	if body.Deleted == nil && body.New == nil && body.Updated == nil {
+		return &DeployResponse{Message: "Deploy failed. nothing to deploy"}
+	}
+	inst.deployMutex.Lock()
+	defer inst.deployMutex.Unlock()
+
+	tx := inst.newDeployTx(body)
+	if !tx.validate() {
+		return tx.response("Deploy failed. validation failed")
+	}
+	// the setters called on the updated objects, and on a rollback, don't send changes; changed() sends them once committed
+	updated := make([]string, 0, len(body.Updated))
+	for _, config := range tx.body.Updated {
+		updated = append(updated, config.GetMeta().GetObjectUUID())
+	}
+	inst.changes.setDeploying(updated, true)
+	defer inst.changes.setDeploying(updated, false)
+	if err := tx.apply(); err != nil {
+		tx.rollback()
+		return tx.response(fmt.Sprintf("Deploy failed. rolled back err: %v", err))
+	}
+	tx.commit()
+	tx.changed()
+	return tx.response(fmt.Sprintf("deployed new: %d updated: %d deleted: %d current objects count: %d", len(body.New), len(body.Updated), len(body.Deleted), len(inst.Get())))
+}
+
+func (inst *RuntimeImpl) newDeployTx(body *Deploy) *deployTx {
+	tx := &deployTx{
+		runtime:  inst,
+		body:     cloneDeploy(body),
+		byUUID:   make(map[string]*DeployResult),
+		previous: append([]Object(nil), inst.Get()...),
+		existing: make(map[string]Object),
+	}
+	for _, object := range tx.previous {
+		tx.existing[object.GetUUID()] = object
+	}
+	if inst.flow != nil {
+		inst.flow.mutex.Lock()
+		tx.flow = inst.flow.built
+		inst.flow.mutex.Unlock()
+	}
+	return tx
+}
+
+// cloneDeploy copies the configs so the deploy never changes the caller's body, eg; the connection conversions
+func cloneDeploy(body *Deploy) *Deploy {
+	clone := &Deploy{Deleted: append([]string(nil), body.Deleted...)}
+	for _, config := range body.New {
+		clone.New = append(clone.New, proto.Clone(config).(*runtime.ObjectConfig))
+	}
+	for _, config := range body.Updated {
+		clone.Updated = append(clone.Updated, proto.Clone(config).(*runtime.ObjectConfig))
+	}
+	return clone
+}
+
+func (tx *deployTx) result(action DeployAction, objectUUID, objectID string) *DeployResult {
+	r := &DeployResult{ObjectUUID: objectUUID, ObjectID: objectID, Action: action, Ok: true}
+	tx.results = append(tx.results, r)
+	if objectUUID != "" {
+		tx.byUUID[objectUUID] = r
+	}
+	return r
+}
+
+func (r *DeployResult) fail(err error) {
+	r.Ok = false
+	r.Error = err.Error()
+}
+
+func validateObjectConfig(config *runtime.ObjectConfig) error {
+	if config == nil {
+		return errors.New("object config is nil")
+	}
+	if config.GetId() == "" {
+		return errors.New("object id is empty")
+	}
+	if config.GetMeta().GetObjectUUID() == "" {
+		return errors.New("object uuid is empty")
+	}
+	return nil
+}
+
+// validate checks all the configs before anything is changed, it returns false if any failed
+func (tx *deployTx) validate() bool {
+	ok := true
+	check := func(r *DeployResult, err error) {
+		if err != nil && r.Ok {
+			r.fail(err)
+			ok = false
+		}
+	}
+	deleted := make(map[string]bool)
+	for _, uuid := range tx.body.Deleted {
+		r := tx.result(DeployDeleted, uuid, "")
+		object := tx.existing[uuid]
+		if object == nil {
+			check(r, fmt.Errorf("not found object with uuid: %s", uuid))
+			continue
+		}
+		r.ObjectID = object.GetID()
+		check(r, checkDelete(object))
+		deleted[uuid] = true
+	}
+
+	final := make(map[string]bool) // the objects after the deploy
+	for uuid := range tx.existing {
+		if !deleted[uuid] {
+			final[uuid] = true
+		}
+	}
+	var configs []*runtime.ObjectConfig
+	var configResults []*DeployResult
+	for _, config := range tx.body.New {
+		r := tx.result(DeployNew, config.GetMeta().GetObjectUUID(), config.GetId())
+		configs, configResults = append(configs, config), append(configResults, r)
+		if err := validateObjectConfig(config); err != nil {
+			check(r, err)
+			continue
+		}
+		uuid := config.GetMeta().GetObjectUUID()
+		if tx.existing[uuid] != nil || final[uuid] {
+			check(r, fmt.Errorf("object with uuid: %s already exists", uuid))
+			continue
+		}
+		if tx.runtime.objectFactory(config.GetId()) == nil {
+			check(r, fmt.Errorf("no object factory registered for object id: %s", config.GetId()))
+		}
+		final[uuid] = true
+	}
+	updated := make(map[string]bool)
+	for _, config := range tx.body.Updated {
+		r := tx.result(DeployUpdated, config.GetMeta().GetObjectUUID(), config.GetId())
+		configs, configResults = append(configs, config), append(configResults, r)
+		if err := validateObjectConfig(config); err != nil {
+			check(r, err)
+			continue
+		}
+		uuid := config.GetMeta().GetObjectUUID()
+		object := tx.existing[uuid]
+		switch {
+		case object == nil:
+			check(r, fmt.Errorf("not found object with uuid: %s", uuid))
+		case deleted[uuid]:
+			check(r, fmt.Errorf("object with uuid: %s can't be updated and deleted", uuid))
+		case updated[uuid]:
+			check(r, fmt.Errorf("object with uuid: %s is updated more than once", uuid))
+		case object.GetID() != config.GetId():
+			check(r, fmt.Errorf("object id can't be changed from: %s to: %s", object.GetID(), config.GetId()))
+		default:
+			changes := diffObjectConfig(tx.runtime.serializeObject(false, object), config)
+			check(r, checkUpdate(object, changes))
+			check(r, checkPortChanges(changes))
+		}
+		updated[uuid] = true
+	}
+
+	// the parents and connections must point to objects that are there after the deploy
+	for i, config := range configs {
+		if config == nil {
+			continue
+		}
+		if parent := config.GetMeta().GetParentUUID(); parent != "" && !final[parent] {
+			check(configResults[i], fmt.Errorf("not found parent object with uuid: %s", parent))
+		}
+		for _, connection := range config.GetConnections() {
+			for _, uuid := range []string{connection.GetSourceUUID(), connection.GetTargetUUID()} {
+				if !final[uuid] {
+					check(configResults[i], fmt.Errorf("connection: %s not found object with uuid: %s", connection.GetConnectionUUID(), uuid))
+				}
+			}
+		}
+	}
+	checkDeleteReferences(tx.previous, deleted, updated, func(uuid string, err error) {
+		check(tx.byUUID[uuid], err)
+	})
+	return ok
+}
+
+// apply builds the new objects, updates the existing objects and then swaps in the new object set, everything that
+// can be checked is checked before the swap
+func (tx *deployTx) apply() error {
+	inst := tx.runtime
+	for _, config := range tx.body.New {
+		r := tx.byUUID[config.GetMeta().GetObjectUUID()]
+		object, err := tx.runtime.buildObject(config)
+		if err != nil {
+			r.fail(err)
+			return fmt.Errorf("new object: %s err: %v", r.ObjectUUID, err)
+		}
+		tx.created = append(tx.created, object)
+	}
+	removed := toSet(tx.body.Deleted)
+	objects := make([]Object, 0, len(tx.previous)+len(tx.created))
+	for _, object := range tx.previous {
+		if !removed[object.GetUUID()] {
+			objects = append(objects, object)
+		}
+	}
+	objects = append(objects, tx.created...)
+	if err := tx.constraints(objects); err != nil {
+		return err
+	}
+
+	for _, config := range tx.body.Updated {
+		r := tx.byUUID[config.GetMeta().GetObjectUUID()]
+		object := tx.existing[r.ObjectUUID]
+		previous, _ := proto.Clone(inst.serializeObject(false, object)).(*runtime.ObjectConfig)
+		tx.updated = append(tx.updated, &deployUpdate{object: object, previous: previous})
+		if err := applyObjectConfig(object, config); err != nil {
+			r.fail(err)
+			return fmt.Errorf("update object: %s err: %v", r.ObjectUUID, err)
+		}
+	}
+	for _, uuid := range tx.body.Deleted {
+		tx.deleted = append(tx.deleted, tx.existing[uuid])
+	}
+	if tx.flow {
+		if _, _, err := flowGraph(objects); err != nil {
+			return err
+		}
+	}
+
+	inst.mutex.Lock()
+	inst.setObjects(objects)
+	inst.mutex.Unlock()
+	inst.restoreObjects(tx.created)
+
+	if tx.flow {
+		if err := inst.flow.Build(); err != nil {
+			return err
+		}
+	}
+	if inst.lifecycle != nil && inst.lifecycle.running() && len(tx.created) > 0 {
+		errs := inst.lifecycle.startObjects(objectUUIDs(tx.created))
+		for _, err := range errs {
+			if r, ok := tx.byUUID[err.ObjectUUID]; ok {
+				r.fail(err)
+			}
+		}
+		if len(errs) > 0 {
+			return errs[0]
+		}
+	}
+	return nil
+}
+
+// constraints checks the Info permissions and requirements of the new objects against the objects after the deploy,
+// and the parent of an updated object that is moved; the new objects are only known once they are built
+func (tx *deployTx) constraints(objects []Object) error {
+	byUUID := make(map[string]Object, len(objects))
+	for _, object := range objects {
+		byUUID[object.GetUUID()] = object
+	}
+	for _, object := range tx.created {
+		err := checkCreate(object, objects)
+		if err == nil {
+			err = checkPlacement(object, byUUID[object.GetParentUUID()])
+		}
+		if err != nil {
+			tx.byUUID[object.GetUUID()].fail(err)
+			return err
+		}
+	}
+	for _, config := range tx.body.Updated {
+		object := tx.existing[config.GetMeta().GetObjectUUID()]
+		parent := config.GetMeta().GetParentUUID()
+		if parent == object.GetParentUUID() {
+			continue
+		}
+		if err := checkPlacement(object, byUUID[parent]); err != nil {
+			tx.byUUID[object.GetUUID()].fail(err)
+			return err
+		}
+	}
+	for _, configs := range [][]*runtime.ObjectConfig{tx.body.New, tx.body.Updated} {
+		for _, config := range configs {
+			if err := tx.checkConversions(config, byUUID); err != nil {
+				tx.byUUID[config.GetMeta().GetObjectUUID()].fail(err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkConversions sets the conversion of the subscriber connections, with strict coercions a connection between types that
+// can not be converted fails the deploy
+func (tx *deployTx) checkConversions(config *runtime.ObjectConfig, byUUID map[string]Object) error {
+	for _, connection := range config.GetConnections() {
+		if connection.GetFlowDirection() != DirectionSubscriber {
+			continue
+		}
+		source, target := byUUID[connection.GetSourceUUID()], byUUID[connection.GetTargetUUID()]
+		if source == nil || target == nil {
+			continue
+		}
+		output, input := source.GetOutput(connection.GetSourcePort()), target.GetInput(connection.GetTargetPort())
+		if output == nil || input == nil {
+			continue
+		}
+		conversion, err := orDefaultCoercions(tx.runtime.Coercions()).Resolve(output.GetDataType(), input.GetDataType())
+		if err != nil {
+			return fmt.Errorf("connection: %s err: %v", connection.GetConnectionUUID(), err)
+		}
+		connection.Conversion = string(conversion)
+	}
+	return nil
+}
+
+// buildObject builds an object with its registered factory, and sets the config
+func (inst *RuntimeImpl) buildObject(config *runtime.ObjectConfig) (object Object, err error) {
+	factory := inst.objectFactory(config.GetId())
+	if factory == nil {
+		return nil, fmt.Errorf("no object factory registered for object id: %s", config.GetId())
+	}
+	err = lifecycleCall(func() error {
+		object, err = factory(config)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if object == nil {
+		return nil, fmt.Errorf("object factory for object id: %s returned nil", config.GetId())
+	}
+	object.AddRuntime(inst)
+	err = applyObjectConfig(object, config)
+	if err == nil && object.GetUUID() != config.GetMeta().GetObjectUUID() {
+		err = fmt.Errorf("object factory for object id: %s returned uuid: %s", config.GetId(), object.GetUUID())
+	}
+	if err != nil {
+		// the object may already have subscribed to its connections
+		if deleteErr := lifecycleCall(object.Delete); deleteErr != nil {
+			log.Printf("deploy object: %s delete err: %v", config.GetMeta().GetObjectUUID(), deleteErr)
+		}
+		return nil, err
+	}
+	return object, nil
+}
+
+// checkPortChanges returns an error if the changes add, remove or change a port, the ports are built by the object
+// so only the port transformations can be updated by a deploy
+func checkPortChanges(changes []*FieldChange) error {
+	for _, change := range changes {
+		if !strings.HasPrefix(change.Field, "inputs.") && !strings.HasPrefix(change.Field, "outputs.") {
+			continue
+		}
+		if strings.HasSuffix(change.Field, ".transformation") {
+			continue
+		}
+		return fmt.Errorf("port change: %s can't be deployed, only the port transformations can be updated", change.Field)
+	}
+	return nil
+}
+
+// applyObjectConfig sets the meta, settings, port transformations and connections of the object from the config
+func applyObjectConfig(object Object, config *runtime.ObjectConfig) error {
+	if err := object.SetMeta(config.GetMeta()); err != nil {
+		return fmt.Errorf("set meta err: %v", err)
+	}
+	if settings := config.GetSettings(); settings != nil {
+		if err := object.SetSettings(settings.GetValue()); err != nil {
+			return fmt.Errorf("set settings err: %v", err)
+		}
+	}
+	if err := applyTransformations(object, config.GetInputs(), object.GetInput); err != nil {
+		return err
+	}
+	if err := applyTransformations(object, config.GetOutputs(), object.GetOutput); err != nil {
+		return err
+	}
+	if errs := object.RemoveOldConnections(config.GetConnections()); len(errs) > 0 {
+		return fmt.Errorf("remove connections err: %v", errors.Join(errs...))
+	}
+	for _, connection := range config.GetConnections() {
+		if object.GetConnection(connection.GetConnectionUUID()) != nil {
+			continue
+		}
+		object.CreateConnection(connection)
+		if connection.GetFlowDirection() == DirectionSubscriber && !connection.GetDisable() {
+			object.AddSubscriptionConnection(connection.GetSourceUUID(), connection.GetSourcePort(), connection.GetTargetUUID(), connection.GetTargetPort())
+		}
+	}
+	return nil
+}
+
+// applyTransformations sets the transformation of each port in the config that differs from the object port, the
+// new transformation is applied to the next value written to the port
+func applyTransformations(object Object, ports []*runtime.Port, getPort func(id string) *Port) error {
+	for _, config := range ports {
+		port := getPort(config.GetId())
+		if port == nil || proto.Equal(PortToProto(port).GetTransformation(), config.GetTransformation()) {
+			continue
+		}
+		var transformation *priority.Transformations
+		if config.GetTransformation() != nil {
+			var err error
+			if transformation, err = convertTransformation(config); err != nil {
+				return fmt.Errorf("port: %s transformation err: %v", config.GetId(), err)
+			}
+		}
+		if err := object.AddTransformation(config.GetId(), transformation, false); err != nil {
+			return fmt.Errorf("port: %s transformation err: %v", config.GetId(), err)
+		}
+	}
+	return nil
+}
+
+// rollback stops and deletes the new objects, so their subscriptions are removed, and puts back the previous objects and their configs
+func (tx *deployTx) rollback() {
+	inst := tx.runtime
+	var stopped []Object
+	if inst.lifecycle != nil && len(tx.created) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), deployStopTimeout)
+		var errs []*LifecycleError
+		stopped, errs = inst.lifecycle.stopObjects(ctx, tx.created)
+		cancel()
+		for _, err := range errs {
+			log.Printf("deploy rollback: %v", err)
+		}
+	}
+	deleteObjects(tx.created, stopped, func(object Object, err error) {
+		log.Printf("deploy rollback object: %s delete err: %v", object.GetUUID(), err)
+	})
+	inst.mutex.Lock()
+	inst.setObjects(tx.previous)
+	inst.mutex.Unlock()
+	for i := len(tx.updated) - 1; i >= 0; i-- {
+		update := tx.updated[i]
+		if err := applyObjectConfig(update.object, update.previous); err != nil {
+			log.Printf("deploy rollback object: %s err: %v", update.object.GetUUID(), err)
+		}
+	}
+	if tx.flow {
+		if err := inst.flow.Build(); err != nil {
+			log.Printf("deploy rollback: %v", err)
+		}
+	}
+	for _, r := range tx.results {
+		if r.Ok {
+			r.Ok = false
+			r.RolledBack = true
+		}
+	}
+}
+
+// commit resets the updated objects and stops the deleted objects, an object that was not stopped by its Delete() hook
+// has Delete() called; a reset can't be undone so it is only called once nothing can roll the deploy back
+func (tx *deployTx) commit() {
+	for _, update := range tx.updated {
+		object := update.object
+		if !object.GetRequirements().GetCallResetOnDeploy() || !object.AllowsReset() {
+			continue
+		}
+		r := tx.byUUID[object.GetUUID()]
+		if err := lifecycleCall(object.Reset); err != nil {
+			r.fail(fmt.Errorf("reset err: %v", err))
+			continue
+		}
+		r.Reset = true
+	}
+	if len(tx.deleted) == 0 {
+		return
+	}
+	var stopped []Object
+	if tx.runtime.lifecycle != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), deployStopTimeout)
+		var errs []*LifecycleError
+		stopped, errs = tx.runtime.lifecycle.stopObjects(ctx, tx.deleted)
+		cancel()
+		for _, err := range errs {
+			tx.byUUID[err.ObjectUUID].fail(err)
+		}
+	}
+	deleteObjects(tx.deleted, stopped, func(object Object, err error) {
+		tx.byUUID[object.GetUUID()].fail(fmt.Errorf("delete err: %v", err))
+	})
+}
+
+// deleteObjects calls Delete() on the objects that were not already deleted by the lifecycle stop, an object without a Stop hook
+// is stopped with Delete()
+func deleteObjects(objects, stopped []Object, fail func(object Object, err error)) {
+	deletedByStop := make(map[string]bool)
+	for _, object := range stopped {
+		if _, ok := object.(ObjectStopper); !ok {
+			deletedByStop[object.GetUUID()] = true
+		}
+	}
+	for _, object := range objects {
+		if deletedByStop[object.GetUUID()] {
+			continue
+		}
+		if err := lifecycleCall(object.Delete); err != nil {
+			fail(object, err)
+		}
+	}
+}
+
+func (tx *deployTx) response(message string) *DeployResponse {
+	resp := &DeployResponse{Message: message, Ok: true, Results: tx.results}
+	for _, r := range tx.results {
+		if !r.Ok {
+			resp.Ok = false
+		}
+		if r.RolledBack {
+			resp.RolledBack = true
+		}
+	}
+	return resp
+}
+
+// changed tells the watchers what the deploy changed, only once it is committed
+func (tx *deployTx) changed() {
+	tx.runtime.objectsChanged(tx.created, tx.deleted)
+	for _, u := range tx.updated {
+		tx.runtime.objectUpdated(u.object, u.previous)
+	}
+}
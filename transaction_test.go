@@ -0,0 +1,224 @@
+package rxlib
+
+import (
+	"errors"
+	"github.com/NubeIO/rxlib/protos/runtimebase/runtime"
+	"testing"
+)
+
+// deployObject only implements what the deploy needs
+type deployObject struct {
+	testObject
+	settings     string
+	connections  []*runtime.Connection
+	requirements *runtime.Requirements
+	resetCount   int
+	deleted      bool
+	settingsErr  error
+	extensions   []Object
+	extensionErr error
+}
+
+func (o *deployObject) AddRuntime(r Runtime) {}
+func (o *deployObject) GetMeta() *runtime.Meta {
+	return &runtime.Meta{ObjectUUID: o.uuid, ObjectName: o.name, ParentUUID: o.parent}
+}
+func (o *deployObject) GetInputs() []*Port             { return nil }
+func (o *deployObject) GetOutputs() []*Port            { return nil }
+func (o *deployObject) GetInput(id string) *Port       { return nil }
+func (o *deployObject) GetOutput(id string) *Port      { return nil }
+func (o *deployObject) GetStats() *runtime.ObjectStats { return nil }
+func (o *deployObject) GetSettings() *runtime.ObjectSettings {
+	return &runtime.ObjectSettings{Value: o.settings}
+}
+func (o *deployObject) GetConnections() []*runtime.Connection { return o.connections }
+func (o *deployObject) GetRequirements() *runtime.Requirements {
+	return o.requirements
+}
+func (o *deployObject) GetExtensions() []Object { return o.extensions }
+func (o *deployObject) AddExtension(extension Object) error {
+	if o.extensionErr != nil {
+		return o.extensionErr
+	}
+	o.extensions = append(o.extensions, extension)
+	return nil
+}
+func (o *deployObject) AllowsReset() bool { return true }
+func (o *deployObject) Reset() error      { o.resetCount++; return nil }
+func (o *deployObject) Delete() error     { o.deleted = true; return nil }
+func (o *deployObject) SetInfo(info *runtime.Info) {
+	o.info, o.workingGroup = info, info.GetWorkingGroup()
+}
+func (o *deployObject) SetStatus(status ObjectStatus) {
+}
+func (o *deployObject) SetMeta(meta *runtime.Meta) error {
+	o.uuid, o.name, o.parent = meta.GetObjectUUID(), meta.GetObjectName(), meta.GetParentUUID()
+	return nil
+}
+func (o *deployObject) SetSettings(settings string) error {
+	if o.settingsErr != nil {
+		return o.settingsErr
+	}
+	o.settings = settings
+	return nil
+}
+func (o *deployObject) RemoveOldConnections(newConnections []*runtime.Connection) []error {
+	o.connections = nil
+	return nil
+}
+func (o *deployObject) GetConnection(uuid string) *runtime.Connection { return nil }
+func (o *deployObject) CreateConnection(connection *runtime.Connection) {
+	o.connections = append(o.connections, connection)
+}
+func (o *deployObject) AddSubscriptionConnection(sourceObjectUUID, sourcePortID, targetObjectUUID, targetPortID string) {
+}
+
+func deployConfig(id, uuid, parent, settings string) *runtime.ObjectConfig {
+	return &runtime.ObjectConfig{
+		Id:       id,
+		Meta:     &runtime.Meta{ObjectUUID: uuid, ObjectName: uuid, ParentUUID: parent},
+		Settings: &runtime.ObjectSettings{Value: settings},
+	}
+}
+
+func newDeployRuntime() (*RuntimeImpl, map[string]*deployObject) {
+	counter := &deployObject{testObject: testObject{uuid: "counter", id: "count"}, settings: "a", requirements: &runtime.Requirements{CallResetOnDeploy: true}}
+	network := &deployObject{testObject: testObject{uuid: "network", id: "network"}, settings: "a"}
+	device := &deployObject{testObject: testObject{uuid: "device", id: "device", parent: "network"}, settings: "a"}
+	r := &RuntimeImpl{}
+	r.AddObjects([]Object{counter, network, device})
+	built := make(map[string]*deployObject)
+	factory := func(config *runtime.ObjectConfig) (Object, error) {
+		o := &deployObject{testObject: testObject{id: config.GetId()}}
+		built[config.GetMeta().GetObjectUUID()] = o
+		return o, nil
+	}
+	r.RegisterObject("add", factory)
+	r.RegisterObject("device", factory)
+	return r, built
+}
+
+func TestDeploy(t *testing.T) {
+	r, built := newDeployRuntime()
+	counter := r.GetByUUID("counter").(*deployObject)
+	network := r.GetByUUID("network").(*deployObject)
+	device := r.GetByUUID("device").(*deployObject)
+	add := deployConfig("add", "add", "", "{}")
+	publisher, subscriber := NewConnection("counter", "out", "add", "in")
+	add.Connections = []*runtime.Connection{subscriber}
+	updatedCounter := deployConfig("count", "counter", "", "b")
+	updatedCounter.Connections = []*runtime.Connection{publisher}
+
+	resp := r.Deploy(&Deploy{
+		New:     []*runtime.ObjectConfig{add},
+		Updated: []*runtime.ObjectConfig{updatedCounter},
+		Deleted: []string{"device", "network"},
+	})
+	if !resp.Ok || resp.RolledBack || len(resp.Results) != 4 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	for _, result := range resp.Results {
+		if !result.Ok {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	}
+	if !resp.Results[3].Reset || counter.resetCount != 1 || counter.settings != "b" || len(counter.connections) != 1 {
+		t.Fatal("expected the counter to be updated and reset")
+	}
+	if r.GetByUUID("add") != built["add"] || built["add"].settings != "{}" || len(built["add"].connections) != 1 {
+		t.Fatal("expected the add object to be built from the config")
+	}
+	if r.GetByUUID("device") != nil || r.GetByUUID("network") != nil || !device.deleted || !network.deleted || len(r.Get()) != 2 {
+		t.Fatal("expected device and network to be deleted")
+	}
+}
+
+func TestDeployValidation(t *testing.T) {
+	r, built := newDeployRuntime()
+	resp := r.Deploy(&Deploy{
+		New: []*runtime.ObjectConfig{
+			deployConfig("add", "counter", "", ""),         // uuid exists
+			deployConfig("unknown", "new-1", "", ""),       // no factory
+			deployConfig("add", "new-2", "missing", ""),    // parent not found
+			deployConfig("add", "new-3", "", ""),           // ok
+			{Id: "add", Meta: &runtime.Meta{}},             // no uuid
+			deployConfig("device", "new-4", "network", ""), // parent is deleted
+		},
+		Updated: []*runtime.ObjectConfig{deployConfig("add", "counter", "", "")}, // id can't change
+		Deleted: []string{"network"},
+	})
+	if resp.Ok || resp.RolledBack || len(resp.Results) != 8 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	var failed int
+	for _, result := range resp.Results {
+		if !result.Ok {
+			failed++
+		}
+	}
+	// the network has the device child, and new-3 is the only valid change
+	if failed != 7 || resp.Results[4].Ok != true {
+		t.Fatalf("unexpected results: %d", failed)
+	}
+	if len(built) != 0 || len(r.Get()) != 3 || r.GetByUUID("counter").(*deployObject).settings != "a" {
+		t.Fatal("expected nothing to be changed")
+	}
+}
+
+func TestDeployDanglingConnections(t *testing.T) {
+	r, _ := newDeployRuntime()
+	counter := r.GetByUUID("counter").(*deployObject)
+	device := r.GetByUUID("device").(*deployObject)
+	publisher, subscriber := NewConnection("counter", "out", "device", "in")
+	counter.connections, device.connections = []*runtime.Connection{publisher}, []*runtime.Connection{subscriber}
+
+	// the counter would be left with a connection to the deleted device
+	resp := r.Deploy(&Deploy{Deleted: []string{"device"}})
+	if resp.Ok || resp.Results[0].Ok {
+		t.Fatalf("expected the delete to fail: %+v", resp.Results[0])
+	}
+	if r.GetByUUID("device") == nil || len(counter.connections) != 1 {
+		t.Fatal("expected nothing to be changed")
+	}
+	// the counter is updated without the connection in the same deploy
+	resp = r.Deploy(&Deploy{Updated: []*runtime.ObjectConfig{deployConfig("count", "counter", "", "a")}, Deleted: []string{"device"}})
+	if !resp.Ok || r.GetByUUID("device") != nil || len(counter.connections) != 0 {
+		t.Fatalf("expected the device to be deleted and the connection removed: %+v", resp)
+	}
+}
+
+func TestDeployRollback(t *testing.T) {
+	r, built := newDeployRuntime()
+	counter := r.GetByUUID("counter").(*deployObject)
+	device := r.GetByUUID("device").(*deployObject)
+	network := r.GetByUUID("network").(*deployObject)
+	network.settingsErr = errors.New("bad settings")
+	resp := r.Deploy(&Deploy{
+		New:     []*runtime.ObjectConfig{deployConfig("add", "add", "", "{}")},
+		Updated: []*runtime.ObjectConfig{deployConfig("count", "counter", "", "b"), deployConfig("network", "network", "", "b")},
+		Deleted: []string{"device"},
+	})
+	if resp.Ok || !resp.RolledBack {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	for _, result := range resp.Results {
+		if result.ObjectUUID == "network" {
+			if result.Ok || result.RolledBack || result.Error == "" {
+				t.Fatalf("unexpected result: %+v", result)
+			}
+		} else if result.Ok || !result.RolledBack {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	}
+	if len(r.Get()) != 3 || r.GetByUUID("add") != nil || r.GetByUUID("device") != device || device.deleted {
+		t.Fatal("expected the previous objects")
+	}
+	// the new object is deleted so it doesn't keep its subscriptions
+	if built["add"] == nil || !built["add"].deleted {
+		t.Fatal("expected the new object to be deleted")
+	}
+	// the reset is only called once the deploy can't be rolled back
+	if counter.settings != "a" || counter.resetCount != 0 {
+		t.Fatal("expected the counter config to be put back and no reset")
+	}
+}
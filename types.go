@@ -10,6 +10,8 @@ const (
 	StatsEnabled        ObjectStatus = "enabled"         // disabled by the user
 	StatsDisabled       ObjectStatus = "disabled"        // disabled by the user
 	StatsIdle           ObjectStatus = "idle"            // idle is waiting for a new message to process
+	StatsError          ObjectStatus = "error"           // the Init(), Start(), Process() or stop hook returned an err, see the object validations
+	StatsStopped        ObjectStatus = "stopped"         // the stop hook has been called on the runtime shutdown
 )
 
 type ObjectType string
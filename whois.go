@@ -31,7 +31,7 @@ func (inst *RuntimeImpl) IamConfig(rangeStart, rangeEnd int) *runtime.ObjectConf
 	if obj == nil {
 		return nil
 	}
-	obj.Invoke(nil) // update all the info
+	_, _ = inst.invoke(obj, nil) // update all the info
 	globalID := obj.GetFlag("globalID")
 	id, err := helpers.ProcessID(globalID)
 	if err != nil {
@@ -51,7 +51,7 @@ func (inst *RuntimeImpl) Iam(rangeStart, rangeEnd int) Object {
 	if obj == nil {
 		return nil
 	}
-	obj.Invoke(nil) // update all the info
+	_, _ = inst.invoke(obj, nil) // update all the info
 	globalID := obj.GetFlag("globalID")
 	id, err := helpers.ProcessID(globalID)
 	if err != nil {